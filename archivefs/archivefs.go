@@ -0,0 +1,252 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package archivefs serves tar, gzip compressed tar and zip archives as
+// read-only file systems, without extracting them.
+package archivefs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+const (
+	blockSize = 4096
+	nameLen   = 255
+
+	// cacheTimeout is how long the kernel may cache entries and
+	// attributes, archives never change.
+	cacheTimeout = time.Hour
+)
+
+// entry is a file of an archive, before it becomes an inode.
+type entry struct {
+	name string
+	attr fuse.Attr
+
+	// hardlink is the name of the entry a hard link points to.
+	hardlink string
+	target   []byte
+	xattrs   map[string][]byte
+	content  io.ReaderAt
+}
+
+// New opens the archive name and returns a read-only file system
+// serving it. The archive stays open as long as the file system is used.
+func New(name string) (fuse.RawFileSystem, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	st, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	rawFS, err := NewReader(f, st.Size())
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return rawFS, nil
+}
+
+// NewReader indexes the archive of size bytes read from r. The format
+// is detected from the first bytes: zip, gzip compressed tar or tar.
+func NewReader(r io.ReaderAt, size int64) (fuse.RawFileSystem, error) {
+	magic := make([]byte, 4)
+	if _, err := r.ReadAt(magic, 0); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	var (
+		entries []*entry
+		err     error
+	)
+	switch {
+	case bytes.HasPrefix(magic, []byte("PK\x03\x04")), bytes.HasPrefix(magic, []byte("PK\x05\x06")):
+		entries, err = indexZip(r, size)
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		entries, err = indexTarGz(r, size)
+	default:
+		entries, err = indexTar(r, size)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	root := &root{entries: entries, files: uint64(len(entries))}
+	root.attr = dirAttr(time.Now())
+	for _, e := range entries {
+		root.size += int64(e.attr.Size)
+	}
+	timeout := cacheTimeout
+	return fs.NewNodeFS(root, &fs.Options{
+		EntryTimeout:    &timeout,
+		AttrTimeout:     &timeout,
+		NullPermissions: true,
+	}), nil
+}
+
+// cleanName returns the path of an entry relative to the root, "" for
+// the root itself. Names escaping the root are rejected.
+func cleanName(name string) (string, bool) {
+	name = path.Clean("/" + name)[1:]
+	if strings.HasPrefix(name, "../") || name == ".." {
+		return "", false
+	}
+	return name, true
+}
+
+// parentName returns the path of the directory holding name.
+func parentName(name string) string {
+	if dir := path.Dir(name); dir != "." {
+		return dir
+	}
+	return ""
+}
+
+// dirAttr returns the attributes of directories without entries.
+func dirAttr(mtime time.Time) fuse.Attr {
+	attr := fuse.Attr{Mode: syscall.S_IFDIR | 0755, Nlink: 2}
+	attr.SetTimes(&mtime, &mtime, &mtime)
+	return attr
+}
+
+// fileMode converts the mode of an archive entry to the one of stat(2).
+func fileMode(m os.FileMode) uint32 {
+	mode := uint32(m.Perm())
+	switch {
+	case m&os.ModeDir != 0:
+		mode |= syscall.S_IFDIR
+	case m&os.ModeSymlink != 0:
+		mode |= syscall.S_IFLNK
+	case m&os.ModeNamedPipe != 0:
+		mode |= syscall.S_IFIFO
+	case m&os.ModeSocket != 0:
+		mode |= syscall.S_IFSOCK
+	case m&os.ModeCharDevice != 0:
+		mode |= syscall.S_IFCHR
+	case m&os.ModeDevice != 0:
+		mode |= syscall.S_IFBLK
+	default:
+		mode |= syscall.S_IFREG
+	}
+	if m&os.ModeSetuid != 0 {
+		mode |= syscall.S_ISUID
+	}
+	if m&os.ModeSetgid != 0 {
+		mode |= syscall.S_ISGID
+	}
+	if m&os.ModeSticky != 0 {
+		mode |= syscall.S_ISVTX
+	}
+	return mode
+}
+
+// mkdev encodes device numbers the way Linux does.
+func mkdev(major, minor int64) uint32 {
+	return uint32(minor&0xff | (major&0xfff)<<8 | (minor&^0xff)<<12)
+}
+
+// root builds the tree of inodes from the entries once it is mounted.
+type root struct {
+	node
+
+	entries []*entry
+	size    int64
+	files   uint64
+}
+
+var _ = (fs.NodeOnAdder)((*root)(nil))
+
+func (r *root) OnAdd(ctx context.Context) {
+	inodes := map[string]*fs.Inode{"": r.EmbeddedInode()}
+
+	var dir func(name string, mtime time.Time) *fs.Inode
+	dir = func(name string, mtime time.Time) *fs.Inode {
+		if inode, ok := inodes[name]; ok && inode.IsDir() {
+			return inode
+		}
+		parent := dir(parentName(name), mtime)
+		inode := parent.NewPersistentInode(ctx, &node{attr: dirAttr(mtime)}, fs.StableAttr{Mode: syscall.S_IFDIR})
+		parent.AddChild(path.Base(name), inode, true)
+		parent.Operations().(nodeAttr).getAttr().Nlink++
+		inodes[name] = inode
+		return inode
+	}
+
+	mtime := time.Unix(int64(r.attr.Mtime), int64(r.attr.Mtimensec))
+	for _, e := range r.entries {
+		if e.name == "" {
+			if e.attr.Mode&syscall.S_IFMT == syscall.S_IFDIR {
+				e.attr.Nlink = r.attr.Nlink
+				r.attr = e.attr
+			}
+			continue
+		}
+		parent := dir(parentName(e.name), mtime)
+		base := path.Base(e.name)
+
+		if e.hardlink != "" {
+			target, ok := inodes[e.hardlink]
+			if !ok || target.IsDir() {
+				continue
+			}
+			parent.AddChild(base, target, true)
+			target.Operations().(nodeAttr).getAttr().Nlink++
+			inodes[e.name] = target
+			continue
+		}
+		if inode, ok := inodes[e.name]; ok && inode.IsDir() && e.attr.Mode&syscall.S_IFMT == syscall.S_IFDIR {
+			// A directory created for its children before its own entry.
+			attr := inode.Operations().(nodeAttr).getAttr()
+			e.attr.Nlink = attr.Nlink
+			*attr = e.attr
+			continue
+		}
+
+		n := &node{attr: e.attr, target: e.target, xattrs: e.xattrs, content: e.content}
+		inode := parent.NewPersistentInode(ctx, n, fs.StableAttr{Mode: e.attr.Mode & syscall.S_IFMT})
+		parent.AddChild(base, inode, true)
+		if n.isDir() {
+			parent.Operations().(nodeAttr).getAttr().Nlink++
+		}
+		inodes[e.name] = inode
+	}
+	r.entries = nil
+}
+
+func (r *root) Statfs(ctx context.Context, out *fuse.StatfsOut) syscall.Errno {
+	*out = fuse.StatfsOut{
+		Blocks:  uint64(r.size+blockSize-1) / blockSize,
+		Files:   r.files,
+		Bsize:   blockSize,
+		NameLen: nameLen,
+		Frsize:  blockSize,
+	}
+	return 0
+}
@@ -0,0 +1,296 @@
+package archivefs_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"math/rand"
+	"os"
+	"path"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chiyutianyi/grpcfuse/archivefs"
+)
+
+var mtime = time.Unix(1600000000, 0)
+
+func content(size int) []byte {
+	data := make([]byte, size)
+	rand.New(rand.NewSource(int64(size))).Read(data)
+	return data
+}
+
+func makeTar(t *testing.T) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	add := func(hdr *tar.Header, data []byte) {
+		hdr.ModTime = mtime
+		hdr.Size = int64(len(data))
+		hdr.Format = tar.FormatPAX
+		require.NoError(t, tw.WriteHeader(hdr))
+		_, err := tw.Write(data)
+		require.NoError(t, err)
+	}
+	add(&tar.Header{Name: "./dir/", Typeflag: tar.TypeDir, Mode: 0700, Uid: 1000}, nil)
+	add(&tar.Header{Name: "./dir/small", Typeflag: tar.TypeReg, Mode: 0644, Uid: 1000,
+		PAXRecords: map[string]string{"SCHILY.xattr.user.a": "value"}}, []byte("hello"))
+	add(&tar.Header{Name: "./implicit/big", Typeflag: tar.TypeReg, Mode: 0600}, content(300<<10))
+	add(&tar.Header{Name: "./symlink", Typeflag: tar.TypeSymlink, Linkname: "dir/small"}, nil)
+	add(&tar.Header{Name: "./hardlink", Typeflag: tar.TypeLink, Linkname: "dir/small"}, nil)
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+// gzipMembers compresses data as many gzip members, like bgzip.
+func gzipMembers(t *testing.T, data []byte, size int) []byte {
+	var buf bytes.Buffer
+	for len(data) > 0 {
+		n := size
+		if n > len(data) {
+			n = len(data)
+		}
+		zw := gzip.NewWriter(&buf)
+		_, err := zw.Write(data[:n])
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+		data = data[n:]
+	}
+	return buf.Bytes()
+}
+
+func makeZip(t *testing.T) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	add := func(hdr *zip.FileHeader, data []byte) {
+		hdr.Modified = mtime
+		w, err := zw.CreateHeader(hdr)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+	}
+	add(&zip.FileHeader{Name: "dir/small", Method: zip.Store}, []byte("hello"))
+	add(&zip.FileHeader{Name: "implicit/big", Method: zip.Deflate}, content(300<<10))
+	symlink := &zip.FileHeader{Name: "symlink"}
+	symlink.SetMode(0777 | os.ModeSymlink)
+	add(symlink, []byte("dir/small"))
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func lookup(t *testing.T, rawFS fuse.RawFileSystem, name string) *fuse.EntryOut {
+	parent := uint64(fuse.FUSE_ROOT_ID)
+	var out fuse.EntryOut
+	for _, part := range bytes.Split([]byte(name), []byte("/")) {
+		require.Equal(t, fuse.OK, rawFS.Lookup(nil, &fuse.InHeader{NodeId: parent}, string(part), &out), name)
+		parent = out.NodeId
+	}
+	return &out
+}
+
+func readAt(t *testing.T, rawFS fuse.RawFileSystem, node uint64, off, size int) []byte {
+	buf := make([]byte, size)
+	res, st := rawFS.Read(nil, &fuse.ReadIn{InHeader: fuse.InHeader{NodeId: node}, Offset: uint64(off), Size: uint32(size)}, buf)
+	require.Equal(t, fuse.OK, st)
+	data, st := res.Bytes(buf)
+	require.Equal(t, fuse.OK, st)
+	return data
+}
+
+func testArchive(t *testing.T, rawFS fuse.RawFileSystem) {
+	small := lookup(t, rawFS, "dir/small")
+	require.Equal(t, uint32(syscall.S_IFREG), small.Mode&syscall.S_IFMT)
+	require.Equal(t, uint64(5), small.Size)
+	require.Equal(t, uint64(mtime.Unix()), small.Mtime)
+	require.Equal(t, "hello", string(readAt(t, rawFS, small.NodeId, 0, 100)))
+	require.Equal(t, "llo", string(readAt(t, rawFS, small.NodeId, 2, 100)))
+
+	require.Equal(t, uint32(syscall.S_IFDIR), lookup(t, rawFS, "implicit").Mode&syscall.S_IFMT)
+	big := lookup(t, rawFS, "implicit/big")
+	want := content(300 << 10)
+	require.Equal(t, uint64(len(want)), big.Size)
+	// Backwards and forwards, within and across gzip members.
+	for _, off := range []int{200 << 10, 1000, 0, 100 << 10, 299 << 10, 150<<10 + 7} {
+		require.Equal(t, want[off:off+1024], readAt(t, rawFS, big.NodeId, off, 1024), off)
+	}
+	rawFS.Release(nil, &fuse.ReleaseIn{InHeader: fuse.InHeader{NodeId: big.NodeId}})
+	require.Empty(t, readAt(t, rawFS, big.NodeId, len(want), 1024))
+
+	symlink := lookup(t, rawFS, "symlink")
+	target, st := rawFS.Readlink(nil, &fuse.InHeader{NodeId: symlink.NodeId})
+	require.Equal(t, fuse.OK, st)
+	require.Equal(t, "dir/small", string(target))
+
+	root := &fuse.InHeader{NodeId: fuse.FUSE_ROOT_ID}
+	var entry fuse.EntryOut
+	require.Equal(t, fuse.EROFS, rawFS.Mkdir(nil, &fuse.MkdirIn{InHeader: *root, Mode: 0755}, "new", &entry))
+	require.Equal(t, fuse.EROFS, rawFS.Create(nil, &fuse.CreateIn{InHeader: *root, Mode: 0644}, "new", &fuse.CreateOut{}))
+	require.Equal(t, fuse.EROFS, rawFS.Unlink(nil, root, "symlink"))
+	require.Equal(t, fuse.EROFS, rawFS.Rename(nil, &fuse.RenameIn{InHeader: *root, Newdir: fuse.FUSE_ROOT_ID}, "symlink", "moved"))
+	require.Equal(t, fuse.EROFS, rawFS.SetXAttr(nil, &fuse.SetXAttrIn{InHeader: *root}, "user.a", nil))
+	in := &fuse.SetAttrIn{}
+	in.NodeId, in.Valid = small.NodeId, fuse.FATTR_SIZE
+	require.Equal(t, fuse.EROFS, rawFS.SetAttr(nil, in, &fuse.AttrOut{}))
+	require.Equal(t, fuse.EROFS, rawFS.Open(nil, &fuse.OpenIn{InHeader: fuse.InHeader{NodeId: small.NodeId}, Flags: syscall.O_RDWR}, &fuse.OpenOut{}))
+}
+
+func TestTar(t *testing.T) {
+	data := makeTar(t)
+	rawFS, err := archivefs.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	testArchive(t, rawFS)
+
+	dir := lookup(t, rawFS, "dir")
+	require.Equal(t, uint32(syscall.S_IFDIR|0700), dir.Mode)
+	require.Equal(t, uint32(1000), dir.Uid)
+
+	small, hardlink := lookup(t, rawFS, "dir/small"), lookup(t, rawFS, "hardlink")
+	require.Equal(t, small.NodeId, hardlink.NodeId)
+	require.Equal(t, uint32(2), hardlink.Nlink)
+
+	buf := make([]byte, 16)
+	sz, st := rawFS.GetXAttr(nil, &fuse.InHeader{NodeId: small.NodeId}, "user.a", buf)
+	require.Equal(t, fuse.OK, st)
+	require.Equal(t, "value", string(buf[:sz]))
+	sz, st = rawFS.ListXAttr(nil, &fuse.InHeader{NodeId: small.NodeId}, buf)
+	require.Equal(t, fuse.OK, st)
+	require.Equal(t, "user.a\x00", string(buf[:sz]))
+}
+
+func TestTarGz(t *testing.T) {
+	tarData := makeTar(t)
+	for _, size := range []int{len(tarData), 64 << 10} {
+		data := gzipMembers(t, tarData, size)
+		rawFS, err := archivefs.NewReader(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		testArchive(t, rawFS)
+	}
+}
+
+// countingReaderAt counts the bytes read from an archive.
+type countingReaderAt struct {
+	r *bytes.Reader
+	n int64
+}
+
+func (c *countingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	n, err := c.r.ReadAt(p, off)
+	atomic.AddInt64(&c.n, int64(n))
+	return n, err
+}
+
+// words returns size bytes of compressible text, with random bytes in
+// between.
+func words(size int) []byte {
+	rnd := rand.New(rand.NewSource(int64(size)))
+	vocabulary := []string{"archive ", "member ", "deflate ", "block ", "window ", "checkpoint\n"}
+	var buf bytes.Buffer
+	for buf.Len() < size {
+		if rnd.Intn(50) == 0 {
+			io.CopyN(&buf, rnd, int64(rnd.Intn(2000)))
+			continue
+		}
+		buf.WriteString(vocabulary[rnd.Intn(len(vocabulary))])
+	}
+	return buf.Bytes()[:size]
+}
+
+func TestTarGzCheckpoints(t *testing.T) {
+	want := words(5 << 20)
+	var tarBuf bytes.Buffer
+	tw := tar.NewWriter(&tarBuf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "big", Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(want))}))
+	_, err := tw.Write(want)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	for _, level := range []int{gzip.NoCompression, gzip.HuffmanOnly, gzip.BestSpeed, gzip.DefaultCompression, gzip.BestCompression} {
+		var buf bytes.Buffer
+		zw, err := gzip.NewWriterLevel(&buf, level)
+		require.NoError(t, err)
+		_, err = zw.Write(tarBuf.Bytes())
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+
+		r := &countingReaderAt{r: bytes.NewReader(buf.Bytes())}
+		rawFS, err := archivefs.NewReader(r, int64(buf.Len()))
+		require.NoError(t, err, level)
+		big := lookup(t, rawFS, "big")
+		require.Equal(t, uint64(len(want)), big.Size)
+
+		// Backwards within the single member, each read decompresses
+		// from a checkpoint close by instead of from the start.
+		for _, off := range []int{len(want) - 1024, 4<<20 + 77, 3 << 20, 1<<20 - 5, 12345, 0} {
+			atomic.StoreInt64(&r.n, 0)
+			require.Equal(t, want[off:off+1024], readAt(t, rawFS, big.NodeId, off, 1024), "level %d off %d", level, off)
+			require.Less(t, atomic.LoadInt64(&r.n), int64(buf.Len()/2), "level %d off %d", level, off)
+		}
+
+		// Far ahead of the previous read of a handle, the read starts
+		// from the checkpoint close by as well.
+		var out fuse.OpenOut
+		require.Equal(t, fuse.OK, rawFS.Open(nil, &fuse.OpenIn{InHeader: fuse.InHeader{NodeId: big.NodeId}}, &out))
+		dest := make([]byte, 1024)
+		for _, off := range []int{0, len(want) - 1024} {
+			atomic.StoreInt64(&r.n, 0)
+			res, st := rawFS.Read(nil, &fuse.ReadIn{InHeader: fuse.InHeader{NodeId: big.NodeId}, Fh: out.Fh, Offset: uint64(off), Size: 1024}, dest)
+			require.Equal(t, fuse.OK, st)
+			got, _ := res.Bytes(dest)
+			require.Equal(t, want[off:off+1024], got, "level %d off %d", level, off)
+			require.Less(t, atomic.LoadInt64(&r.n), int64(buf.Len()/2), "level %d off %d", level, off)
+		}
+		rawFS.Release(nil, &fuse.ReleaseIn{InHeader: fuse.InHeader{NodeId: big.NodeId}, Fh: out.Fh})
+	}
+}
+
+func TestHandles(t *testing.T) {
+	tarData := makeTar(t)
+	data := gzipMembers(t, tarData, len(tarData))
+	rawFS, err := archivefs.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	big := lookup(t, rawFS, "implicit/big")
+	want := content(300 << 10)
+
+	open := func() uint64 {
+		var out fuse.OpenOut
+		require.Equal(t, fuse.OK, rawFS.Open(nil, &fuse.OpenIn{InHeader: fuse.InHeader{NodeId: big.NodeId}}, &out))
+		return out.Fh
+	}
+	read := func(fh uint64, off int) []byte {
+		buf := make([]byte, 1024)
+		res, st := rawFS.Read(nil, &fuse.ReadIn{InHeader: fuse.InHeader{NodeId: big.NodeId}, Fh: fh, Offset: uint64(off), Size: 1024}, buf)
+		require.Equal(t, fuse.OK, st)
+		got, st := res.Bytes(buf)
+		require.Equal(t, fuse.OK, st)
+		return got
+	}
+
+	fh1, fh2 := open(), open()
+	for off := 0; off < 8<<10; off += 1024 {
+		require.Equal(t, want[off:off+1024], read(fh1, off))
+		require.Equal(t, want[100<<10+off:100<<10+off+1024], read(fh2, 100<<10+off))
+	}
+	// Releasing one handle leaves the reader of the other alone.
+	rawFS.Release(nil, &fuse.ReleaseIn{InHeader: fuse.InHeader{NodeId: big.NodeId}, Fh: fh1})
+	require.Equal(t, want[108<<10:109<<10], read(fh2, 108<<10))
+	rawFS.Release(nil, &fuse.ReleaseIn{InHeader: fuse.InHeader{NodeId: big.NodeId}, Fh: fh2})
+}
+
+func TestZip(t *testing.T) {
+	data := makeZip(t)
+	rawFS, err := archivefs.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	testArchive(t, rawFS)
+}
+
+func TestNew(t *testing.T) {
+	_, err := archivefs.New(path.Join(t.TempDir(), "missing.tar"))
+	require.Error(t, err)
+}
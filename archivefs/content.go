@@ -0,0 +1,437 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package archivefs
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"sort"
+	"sync"
+)
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// streamContent reads entries which can only be decompressed from their
+// start or from a checkpoint. ReadAt decompresses anew every time, the
+// readers of open handles go on where their previous read stopped.
+type streamContent struct {
+	size int64
+	// open returns a reader positioned at pos, at most off.
+	open func(off int64) (r io.Reader, pos int64, err error)
+	// start returns the pos open returns for off, without opening a
+	// reader. It is nil if open always starts at 0.
+	start func(off int64) int64
+}
+
+func (c *streamContent) ReadAt(p []byte, off int64) (int, error) {
+	r := c.newReader()
+	defer r.Close()
+	return r.ReadAt(p, off)
+}
+
+// newReader returns a reader keeping its decompressor between reads.
+func (c *streamContent) newReader() *streamReader {
+	return &streamReader{c: c}
+}
+
+// streamReader reads a streamContent for one open handle. It keeps the
+// decompressor of the last read so that sequential reads go on where the
+// previous one stopped.
+type streamReader struct {
+	c *streamContent
+
+	mu  sync.Mutex
+	r   io.Reader
+	pos int64
+}
+
+func (s *streamReader) ReadAt(p []byte, off int64) (int, error) {
+	if off >= s.c.size {
+		return 0, io.EOF
+	}
+	if rest := s.c.size - off; int64(len(p)) > rest {
+		p = p[:rest]
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Reopen rather than decompress up to off if a checkpoint lies in
+	// between.
+	if s.r == nil || off < s.pos || s.c.start != nil && s.c.start(off) > s.pos {
+		s.reset()
+		r, pos, err := s.c.open(off)
+		if err != nil {
+			return 0, err
+		}
+		s.r, s.pos = r, pos
+	}
+	if _, err := io.CopyN(io.Discard, s.r, off-s.pos); err != nil {
+		s.reset()
+		return 0, err
+	}
+	n, err := io.ReadFull(s.r, p)
+	s.pos = off + int64(n)
+	if err != nil {
+		s.reset()
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+	}
+	return n, err
+}
+
+// Close drops the decompressor until the next read.
+func (s *streamReader) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reset()
+	return nil
+}
+
+func (s *streamReader) reset() {
+	if closer, ok := s.r.(io.Closer); ok {
+		closer.Close()
+	}
+	s.r = nil
+}
+
+// checkpointSpan is how much uncompressed data at most lies between two
+// checkpoints within a gzip member.
+const checkpointSpan = 1 << 20
+
+// checkpoint is where decompression can begin without the data before
+// it: the start of a gzip member, or of a deflate block within one.
+type checkpoint struct {
+	in   int64 // offset in the compressed stream
+	bits uint  // bits of the byte at in the block starts after
+	out  int64 // offset in the uncompressed stream
+	// window holds the data the block may refer to, nil at the start of
+	// a member.
+	window []byte
+}
+
+// memberReader decompresses a gzip stream one member at a time,
+// recording a checkpoint at the start of each, and then at the first
+// block after every checkpointSpan bytes. Any offset can be read from
+// after decompressing at most checkpointSpan bytes and a block, whether
+// the stream has a single member or many, as written by bgzip.
+type memberReader struct {
+	f *inflater
+	// base is the uncompressed offset of the current member.
+	base int64
+	out  int64
+	crc  uint32
+	// inMember tells whether the header of the current member is read.
+	inMember bool
+
+	checkpoints []checkpoint
+}
+
+func newMemberReader(r io.Reader) (*memberReader, error) {
+	m := &memberReader{f: newInflater(bufio.NewReader(r))}
+	m.f.onBlock = m.onBlock
+	if err := m.readHeader(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// onBlock records a checkpoint at the block about to be decompressed.
+func (m *memberReader) onBlock() {
+	out := m.base + m.f.out
+	if out-m.checkpoints[len(m.checkpoints)-1].out < checkpointSpan {
+		return
+	}
+	pos := m.f.bitPos()
+	m.checkpoints = append(m.checkpoints, checkpoint{
+		in:     pos / 8,
+		bits:   uint(pos % 8),
+		out:    out,
+		window: m.f.window(),
+	})
+}
+
+// readHeader reads the header of the next member, returning io.EOF at
+// the end of the stream.
+func (m *memberReader) readHeader() error {
+	in := (m.f.bitPos() + 7) / 8
+	var hdr [10]byte
+	for i := range hdr {
+		b, err := m.f.readByte()
+		if err == io.EOF && i > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return err
+		}
+		hdr[i] = b
+	}
+	if hdr[0] != 0x1f || hdr[1] != 0x8b || hdr[2] != 8 {
+		return gzip.ErrHeader
+	}
+
+	flags := hdr[3]
+	if flags&0x04 != 0 { // FEXTRA
+		lo, err := m.headerByte()
+		if err != nil {
+			return err
+		}
+		hi, err := m.headerByte()
+		if err != nil {
+			return err
+		}
+		for n := int(lo) | int(hi)<<8; n > 0; n-- {
+			if _, err := m.headerByte(); err != nil {
+				return err
+			}
+		}
+	}
+	for _, flag := range []byte{0x08, 0x10} { // FNAME, FCOMMENT
+		if flags&flag == 0 {
+			continue
+		}
+		for {
+			b, err := m.headerByte()
+			if err != nil {
+				return err
+			}
+			if b == 0 {
+				break
+			}
+		}
+	}
+	if flags&0x02 != 0 { // FHCRC
+		for i := 0; i < 2; i++ {
+			if _, err := m.headerByte(); err != nil {
+				return err
+			}
+		}
+	}
+
+	m.f.reset()
+	m.base = m.out
+	m.crc = 0
+	m.inMember = true
+	m.checkpoints = append(m.checkpoints, checkpoint{in: in, out: m.out})
+	return nil
+}
+
+func (m *memberReader) headerByte() (byte, error) {
+	b, err := m.f.readByte()
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return b, err
+}
+
+// readTrailer checks the checksum and size of the member just read.
+func (m *memberReader) readTrailer() error {
+	var trailer [8]byte
+	for i := range trailer {
+		b, err := m.headerByte()
+		if err != nil {
+			return err
+		}
+		trailer[i] = b
+	}
+	if binary.LittleEndian.Uint32(trailer[:4]) != m.crc ||
+		binary.LittleEndian.Uint32(trailer[4:]) != uint32(m.out-m.base) {
+		return gzip.ErrChecksum
+	}
+	m.inMember = false
+	return nil
+}
+
+func (m *memberReader) Read(p []byte) (int, error) {
+	for {
+		if !m.inMember {
+			if err := m.readHeader(); err != nil {
+				return 0, err
+			}
+		}
+		n, err := m.f.Read(p)
+		m.out += int64(n)
+		m.crc = crc32.Update(m.crc, crc32.IEEETable, p[:n])
+		if err != io.EOF {
+			return n, err
+		}
+		if err := m.readTrailer(); err != nil {
+			return n, err
+		}
+		if n > 0 {
+			// Move to the next member on the next call.
+			return n, nil
+		}
+	}
+}
+
+// gzipIndex opens a gzip stream at the checkpoint closest to an offset.
+type gzipIndex struct {
+	r           io.ReaderAt
+	size        int64
+	checkpoints []checkpoint
+}
+
+// open returns a reader of the uncompressed stream starting at pos, at
+// most off.
+func (g *gzipIndex) open(off int64) (io.Reader, int64, error) {
+	i := g.checkpoint(off)
+	c := g.checkpoints[i]
+	if c.window == nil {
+		r, err := g.openMember(c)
+		return r, c.out, err
+	}
+
+	// Within a member, compress/flate decompresses up to its end, the
+	// next members are read by gzip.
+	br := bufio.NewReader(io.NewSectionReader(g.r, c.in, g.size-c.in))
+	var r io.Reader = br
+	if c.bits > 0 {
+		r = &bitReader{r: br, shift: c.bits}
+	}
+	mr := &membersReader{r: flate.NewReaderDict(r, c.window)}
+	for _, next := range g.checkpoints[i+1:] {
+		if next.window == nil {
+			mr.next = func() (io.ReadCloser, error) {
+				return g.openMember(next)
+			}
+			break
+		}
+	}
+	return mr, c.out, nil
+}
+
+// checkpoint returns the index of the last checkpoint at or before off.
+func (g *gzipIndex) checkpoint(off int64) int {
+	i := sort.Search(len(g.checkpoints), func(i int) bool {
+		return g.checkpoints[i].out > off
+	}) - 1
+	if i < 0 {
+		i = 0
+	}
+	return i
+}
+
+// openMember decompresses the members from the start of the one of c.
+func (g *gzipIndex) openMember(c checkpoint) (io.ReadCloser, error) {
+	return gzip.NewReader(bufio.NewReader(io.NewSectionReader(g.r, c.in, g.size-c.in)))
+}
+
+// membersReader reads r to its end, and then the reader next returns.
+type membersReader struct {
+	r    io.ReadCloser
+	next func() (io.ReadCloser, error)
+}
+
+func (m *membersReader) Read(p []byte) (int, error) {
+	for {
+		n, err := m.r.Read(p)
+		if err != io.EOF || m.next == nil {
+			return n, err
+		}
+		r, err := m.next()
+		m.next = nil
+		if err != nil {
+			return n, err
+		}
+		m.r.Close()
+		m.r = r
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+func (m *membersReader) Close() error {
+	return m.r.Close()
+}
+
+// bitReader shifts a stream by shift bits, so that compress/flate can
+// read deflate blocks starting within a byte.
+type bitReader struct {
+	r     io.ByteReader
+	shift uint
+	cur   byte
+	read  bool
+	done  bool
+}
+
+func (b *bitReader) ReadByte() (byte, error) {
+	if !b.read {
+		cur, err := b.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		b.cur, b.read = cur, true
+	}
+	if b.done {
+		return 0, io.EOF
+	}
+	next, err := b.r.ReadByte()
+	if err == io.EOF {
+		// The last bits, padded with zeros.
+		b.done = true
+		return b.cur >> b.shift, nil
+	} else if err != nil {
+		return 0, err
+	}
+	v := b.cur>>b.shift | next<<(8-b.shift)
+	b.cur = next
+	return v, nil
+}
+
+func (b *bitReader) Read(p []byte) (int, error) {
+	for i := range p {
+		c, err := b.ReadByte()
+		if err != nil {
+			return i, err
+		}
+		p[i] = c
+	}
+	return len(p), nil
+}
+
+// entry returns the content of size bytes at off in the uncompressed
+// stream.
+func (g *gzipIndex) entry(off, size int64) io.ReaderAt {
+	return &streamContent{
+		size: size,
+		open: func(pos int64) (io.Reader, int64, error) {
+			r, start, err := g.open(off + pos)
+			return r, start - off, err
+		},
+		start: func(pos int64) int64 {
+			return g.checkpoints[g.checkpoint(off+pos)].out - off
+		},
+	}
+}
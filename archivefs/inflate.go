@@ -0,0 +1,451 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package archivefs
+
+import (
+	"compress/flate"
+	"io"
+	"math/bits"
+)
+
+// windowSize is how far back deflate matches may reach.
+const windowSize = 1 << 15
+
+var (
+	// codeOrder is the order of the code length code lengths.
+	codeOrder = [19]int{16, 17, 18, 0, 8, 7, 9, 6, 10, 5, 11, 4, 12, 3, 13, 2, 14, 1, 15}
+
+	lengthBase  = [29]uint16{3, 4, 5, 6, 7, 8, 9, 10, 11, 13, 15, 17, 19, 23, 27, 31, 35, 43, 51, 59, 67, 83, 99, 115, 131, 163, 195, 227, 258}
+	lengthExtra = [29]uint8{0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 4, 5, 5, 5, 5, 0}
+	distBase    = [30]uint16{1, 2, 3, 4, 5, 7, 9, 13, 17, 25, 33, 49, 65, 97, 129, 193, 257, 385, 513, 769, 1025, 1537, 2049, 3073, 4097, 6145, 8193, 12289, 16385, 24577}
+	distExtra   = [30]uint8{0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13}
+
+	fixedLit, fixedDist huffman
+)
+
+func init() {
+	var lengths [288 + 30]uint8
+	for i := range lengths {
+		switch {
+		case i < 144:
+			lengths[i] = 8
+		case i < 256:
+			lengths[i] = 9
+		case i < 280:
+			lengths[i] = 7
+		case i < 288:
+			lengths[i] = 8
+		default:
+			lengths[i] = 5
+		}
+	}
+	fixedLit.init(lengths[:288])
+	fixedDist.init(lengths[288:])
+}
+
+// huffman decodes the codes of a deflate block with a single table.
+type huffman struct {
+	// table holds symbol<<4 | code length, indexed by the next maxLen
+	// bits of the input. Unused codes have length 0.
+	table  []uint32
+	maxLen uint
+}
+
+// init builds the canonical code of lengths, reporting over-subscribed
+// ones. Incomplete codes are allowed, using their missing codes fails.
+func (h *huffman) init(lengths []uint8) bool {
+	var count, next [16]int
+	maxLen := 0
+	for _, l := range lengths {
+		count[l]++
+		if int(l) > maxLen {
+			maxLen = int(l)
+		}
+	}
+	count[0] = 0
+	left := 1
+	for l := 1; l < 16; l++ {
+		left = left<<1 - count[l]
+		if left < 0 {
+			return false
+		}
+	}
+	code := 0
+	for l := 1; l < 16; l++ {
+		code = (code + count[l-1]) << 1
+		next[l] = code
+	}
+
+	size := 1 << maxLen
+	if cap(h.table) < size {
+		h.table = make([]uint32, size)
+	}
+	h.table = h.table[:size]
+	clear(h.table)
+	h.maxLen = uint(maxLen)
+	for sym, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		reversed := int(bits.Reverse16(uint16(next[l])) >> (16 - l))
+		next[l]++
+		for i := reversed; i < size; i += 1 << l {
+			h.table[i] = uint32(sym)<<4 | uint32(l)
+		}
+	}
+	return true
+}
+
+const (
+	stateHeader = iota
+	stateStored
+	stateHuffman
+	stateDone
+)
+
+// inflater decompresses a raw deflate stream like compress/flate, but
+// tells where its blocks start, in bits of the input, and what the
+// window is there. Decompression can resume from any block with
+// flate.NewReaderDict, given the window. Only indexers use it, reading
+// goes through compress/flate.
+type inflater struct {
+	r   io.ByteReader
+	in  int64 // bytes read from r
+	err error // of r
+
+	bits  uint64
+	nbits uint
+
+	// onBlock is called before each block is decoded.
+	onBlock func()
+
+	state   int
+	final   bool
+	stored  int
+	lit     *huffman
+	dist    *huffman
+	dynLit  huffman
+	dynDist huffman
+	codes   huffman
+	lengths [286 + 30]uint8
+
+	copyLen  int
+	copyDist int
+
+	// out counts the bytes of the stream, the last windowSize of which
+	// are in hist.
+	out  int64
+	hist [windowSize]byte
+}
+
+func newInflater(r io.ByteReader) *inflater {
+	return &inflater{r: r}
+}
+
+// reset starts the next deflate stream where the last one ended.
+func (f *inflater) reset() {
+	f.state = stateHeader
+	f.final = false
+	f.copyLen = 0
+	f.out = 0
+}
+
+// bitPos returns the offset of the next bit of the input.
+func (f *inflater) bitPos() int64 {
+	return f.in*8 - int64(f.nbits)
+}
+
+// window returns a copy of the last windowSize bytes of the stream.
+func (f *inflater) window() []byte {
+	n := int64(windowSize)
+	if f.out < n {
+		n = f.out
+	}
+	w := make([]byte, n)
+	start := int((f.out - n) % windowSize)
+	c := copy(w, f.hist[start:])
+	copy(w[c:], f.hist[:int(n)-c])
+	return w
+}
+
+func (f *inflater) fill(n uint) {
+	for f.nbits < n && f.err == nil {
+		b, err := f.r.ReadByte()
+		if err != nil {
+			f.err = err
+			return
+		}
+		f.in++
+		f.bits |= uint64(b) << f.nbits
+		f.nbits += 8
+	}
+}
+
+// inputError returns the error of a read past the end of the input.
+func (f *inflater) inputError() error {
+	if f.err == nil || f.err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return f.err
+}
+
+func (f *inflater) readBits(n uint) (int, error) {
+	f.fill(n)
+	if f.nbits < n {
+		return 0, f.inputError()
+	}
+	v := int(f.bits & (1<<n - 1))
+	f.bits >>= n
+	f.nbits -= n
+	return v, nil
+}
+
+// readByte skips to the next byte boundary and reads a byte, returning
+// io.EOF at the end of the input.
+func (f *inflater) readByte() (byte, error) {
+	f.bits >>= f.nbits % 8
+	f.nbits -= f.nbits % 8
+	if f.nbits == 0 {
+		b, err := f.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		f.in++
+		return b, nil
+	}
+	b, _ := f.readBits(8)
+	return byte(b), nil
+}
+
+func (f *inflater) decode(h *huffman) (int, error) {
+	f.fill(h.maxLen)
+	e := h.table[f.bits&(1<<h.maxLen-1)]
+	l := uint(e & 15)
+	if l > f.nbits {
+		return 0, f.inputError()
+	}
+	if l == 0 {
+		return 0, flate.CorruptInputError(f.in)
+	}
+	f.bits >>= l
+	f.nbits -= l
+	return int(e >> 4), nil
+}
+
+func (f *inflater) emit(b byte) {
+	f.hist[f.out%windowSize] = b
+	f.out++
+}
+
+// Read returns the decompressed stream, io.EOF after its final block.
+func (f *inflater) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if f.copyLen > 0 {
+			b := f.hist[(f.out-int64(f.copyDist))%windowSize]
+			f.emit(b)
+			p[n] = b
+			n++
+			f.copyLen--
+			continue
+		}
+
+		switch f.state {
+		case stateHeader:
+			if f.final {
+				f.state = stateDone
+				continue
+			}
+			if f.onBlock != nil {
+				f.onBlock()
+			}
+			if err := f.readHeader(); err != nil {
+				return n, err
+			}
+		case stateStored:
+			if f.stored == 0 {
+				f.state = stateHeader
+				continue
+			}
+			b, err := f.readBits(8)
+			if err != nil {
+				return n, err
+			}
+			f.emit(byte(b))
+			p[n] = byte(b)
+			n++
+			f.stored--
+		case stateHuffman:
+			sym, err := f.decode(f.lit)
+			if err != nil {
+				return n, err
+			}
+			switch {
+			case sym < 256:
+				f.emit(byte(sym))
+				p[n] = byte(sym)
+				n++
+			case sym == 256:
+				f.state = stateHeader
+			default:
+				if err := f.readMatch(sym - 257); err != nil {
+					return n, err
+				}
+			}
+		case stateDone:
+			if n > 0 {
+				return n, nil
+			}
+			return 0, io.EOF
+		}
+	}
+	return n, nil
+}
+
+func (f *inflater) readMatch(sym int) error {
+	if sym >= len(lengthBase) {
+		return flate.CorruptInputError(f.in)
+	}
+	extra, err := f.readBits(uint(lengthExtra[sym]))
+	if err != nil {
+		return err
+	}
+	length := int(lengthBase[sym]) + extra
+
+	sym, err = f.decode(f.dist)
+	if err != nil {
+		return err
+	}
+	if sym >= len(distBase) {
+		return flate.CorruptInputError(f.in)
+	}
+	if extra, err = f.readBits(uint(distExtra[sym])); err != nil {
+		return err
+	}
+	dist := int(distBase[sym]) + extra
+	if int64(dist) > f.out {
+		return flate.CorruptInputError(f.in)
+	}
+	f.copyLen, f.copyDist = length, dist
+	return nil
+}
+
+func (f *inflater) readHeader() error {
+	v, err := f.readBits(3)
+	if err != nil {
+		return err
+	}
+	f.final = v&1 != 0
+	switch v >> 1 {
+	case 0:
+		f.bits >>= f.nbits % 8
+		f.nbits -= f.nbits % 8
+		length, err := f.readBits(16)
+		if err != nil {
+			return err
+		}
+		nlength, err := f.readBits(16)
+		if err != nil {
+			return err
+		}
+		if length != ^nlength&0xffff {
+			return flate.CorruptInputError(f.in)
+		}
+		f.stored = length
+		f.state = stateStored
+	case 1:
+		f.lit, f.dist = &fixedLit, &fixedDist
+		f.state = stateHuffman
+	case 2:
+		if err := f.readDynamic(); err != nil {
+			return err
+		}
+		f.lit, f.dist = &f.dynLit, &f.dynDist
+		f.state = stateHuffman
+	default:
+		return flate.CorruptInputError(f.in)
+	}
+	return nil
+}
+
+func (f *inflater) readDynamic() error {
+	v, err := f.readBits(14)
+	if err != nil {
+		return err
+	}
+	nlit, ndist, nclen := v&31+257, v>>5&31+1, v>>10+4
+	if nlit > 286 || ndist > 30 {
+		return flate.CorruptInputError(f.in)
+	}
+
+	var clen [19]uint8
+	for i := 0; i < nclen; i++ {
+		l, err := f.readBits(3)
+		if err != nil {
+			return err
+		}
+		clen[codeOrder[i]] = uint8(l)
+	}
+	if !f.codes.init(clen[:]) {
+		return flate.CorruptInputError(f.in)
+	}
+
+	lengths := f.lengths[:nlit+ndist]
+	for i := 0; i < len(lengths); {
+		sym, err := f.decode(&f.codes)
+		if err != nil {
+			return err
+		}
+		if sym < 16 {
+			lengths[i] = uint8(sym)
+			i++
+			continue
+		}
+
+		var rep int
+		var value uint8
+		switch sym {
+		case 16:
+			if i == 0 {
+				return flate.CorruptInputError(f.in)
+			}
+			rep, err = f.readBits(2)
+			rep += 3
+			value = lengths[i-1]
+		case 17:
+			rep, err = f.readBits(3)
+			rep += 3
+		default:
+			rep, err = f.readBits(7)
+			rep += 11
+		}
+		if err != nil {
+			return err
+		}
+		if i+rep > len(lengths) {
+			return flate.CorruptInputError(f.in)
+		}
+		for ; rep > 0; rep-- {
+			lengths[i] = value
+			i++
+		}
+	}
+	if lengths[256] == 0 || !f.dynLit.init(lengths[:nlit]) || !f.dynDist.init(lengths[nlit:]) {
+		return flate.CorruptInputError(f.in)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package archivefs
+
+import (
+	"context"
+	"io"
+	"sort"
+	"syscall"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
+)
+
+// node is a file, directory, symlink or special file of an archive.
+// Nodes do not change once the tree is built.
+type node struct {
+	fs.Inode
+
+	attr    fuse.Attr
+	target  []byte
+	xattrs  map[string][]byte
+	content io.ReaderAt
+}
+
+// nodeAttr gives access to the attributes of both node and root while
+// the tree is built.
+type nodeAttr interface {
+	getAttr() *fuse.Attr
+}
+
+func (n *node) getAttr() *fuse.Attr {
+	return &n.attr
+}
+
+var (
+	_ = (fs.NodeGetattrer)((*node)(nil))
+	_ = (fs.NodeReadlinker)((*node)(nil))
+	_ = (fs.NodeGetxattrer)((*node)(nil))
+	_ = (fs.NodeListxattrer)((*node)(nil))
+	_ = (fs.NodeOpener)((*node)(nil))
+	_ = (fs.NodeReader)((*node)(nil))
+	_ = (fs.NodeReleaser)((*node)(nil))
+)
+
+func (n *node) isDir() bool {
+	return n.attr.Mode&syscall.S_IFMT == syscall.S_IFDIR
+}
+
+func (n *node) Getattr(ctx context.Context, f fs.FileHandle, out *fuse.AttrOut) syscall.Errno {
+	out.Attr = n.attr
+	out.Ino = n.StableAttr().Ino
+	if n.isDir() {
+		out.Size = blockSize
+	}
+	out.Blocks = (out.Size + 511) / 512
+	return 0
+}
+
+func (n *node) Readlink(ctx context.Context) ([]byte, syscall.Errno) {
+	if n.attr.Mode&syscall.S_IFMT != syscall.S_IFLNK {
+		return nil, syscall.EINVAL
+	}
+	return n.target, 0
+}
+
+func (n *node) Getxattr(ctx context.Context, attr string, dest []byte) (uint32, syscall.Errno) {
+	value, ok := n.xattrs[attr]
+	if !ok {
+		return 0, syscall.Errno(fuse.ENOATTR)
+	}
+	if len(dest) < len(value) {
+		return uint32(len(value)), syscall.ERANGE
+	}
+	return uint32(copy(dest, value)), 0
+}
+
+func (n *node) Listxattr(ctx context.Context, dest []byte) (uint32, syscall.Errno) {
+	var list []byte
+	for _, name := range sortedKeys(n.xattrs) {
+		list = append(list, name...)
+		list = append(list, 0)
+	}
+	if len(dest) < len(list) {
+		return uint32(len(list)), syscall.ERANGE
+	}
+	return uint32(copy(dest, list)), 0
+}
+
+func sortedKeys(m map[string][]byte) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (n *node) Open(ctx context.Context, flags uint32) (fs.FileHandle, uint32, syscall.Errno) {
+	if flags&(syscall.O_WRONLY|syscall.O_RDWR|syscall.O_TRUNC|syscall.O_APPEND) != 0 {
+		return nil, 0, syscall.EROFS
+	}
+	if c, ok := n.content.(*streamContent); ok {
+		// Every handle decompresses on its own, so that sequential
+		// reads of one do not restart those of another.
+		return c.newReader(), fuse.FOPEN_KEEP_CACHE, 0
+	}
+	return nil, fuse.FOPEN_KEEP_CACHE, 0
+}
+
+func (n *node) Read(ctx context.Context, f fs.FileHandle, dest []byte, off int64) (fuse.ReadResult, syscall.Errno) {
+	if n.content == nil {
+		return fuse.ReadResultData(nil), 0
+	}
+	content := n.content
+	if r, ok := f.(*streamReader); ok {
+		content = r
+	}
+	c, err := content.ReadAt(dest, off)
+	if err != nil && err != io.EOF {
+		log.Errorf("Read archive entry at %d: %v", off, err)
+		return nil, syscall.EIO
+	}
+	return fuse.ReadResultData(dest[:c]), 0
+}
+
+// Release drops the decompressor the handle kept for sequential reads.
+func (n *node) Release(ctx context.Context, f fs.FileHandle) syscall.Errno {
+	if r, ok := f.(*streamReader); ok {
+		r.Close()
+	}
+	return 0
+}
@@ -0,0 +1,138 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package archivefs
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"strings"
+	"syscall"
+)
+
+// paxXattr prefixes the PAX records holding extended attributes.
+const paxXattr = "SCHILY.xattr."
+
+func indexTar(r io.ReaderAt, size int64) ([]*entry, error) {
+	cr := &countingReader{r: io.NewSectionReader(r, 0, size)}
+	return readTar(cr, func(off, size int64) io.ReaderAt {
+		return io.NewSectionReader(r, off, size)
+	})
+}
+
+func indexTarGz(r io.ReaderAt, size int64) ([]*entry, error) {
+	m, err := newMemberReader(io.NewSectionReader(r, 0, size))
+	if err != nil {
+		return nil, err
+	}
+	index := &gzipIndex{r: r, size: size}
+	cr := &countingReader{r: m}
+	entries, err := readTar(cr, index.entry)
+	if err != nil {
+		return nil, err
+	}
+	index.checkpoints = m.checkpoints
+	return entries, nil
+}
+
+// isSparse tells whether the data of hdr is stored as a sparse file, in
+// which case it is not a contiguous range of the tar stream.
+func isSparse(hdr *tar.Header) bool {
+	if hdr.Typeflag == tar.TypeGNUSparse {
+		return true
+	}
+	for k := range hdr.PAXRecords {
+		if strings.HasPrefix(k, "GNU.sparse.") {
+			return true
+		}
+	}
+	return false
+}
+
+// readTar indexes the tar stream read from cr. The data of an entry
+// starts where cr stands once its header is read, as tar.Reader reads
+// whole blocks without buffering.
+func readTar(cr *countingReader, content func(off, size int64) io.ReaderAt) ([]*entry, error) {
+	tr := tar.NewReader(cr)
+	var entries []*entry
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		name, ok := cleanName(hdr.Name)
+		if !ok {
+			continue
+		}
+
+		e := &entry{name: name}
+		e.attr.Mode = fileMode(hdr.FileInfo().Mode())
+		e.attr.Nlink = 1
+		e.attr.Uid, e.attr.Gid = uint32(hdr.Uid), uint32(hdr.Gid)
+		atime, ctime := hdr.AccessTime, hdr.ChangeTime
+		if atime.IsZero() {
+			atime = hdr.ModTime
+		}
+		if ctime.IsZero() {
+			ctime = hdr.ModTime
+		}
+		e.attr.SetTimes(&atime, &hdr.ModTime, &ctime)
+		for k, v := range hdr.PAXRecords {
+			if strings.HasPrefix(k, paxXattr) {
+				if e.xattrs == nil {
+					e.xattrs = make(map[string][]byte)
+				}
+				e.xattrs[strings.TrimPrefix(k, paxXattr)] = []byte(v)
+			}
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeLink:
+			if e.hardlink, ok = cleanName(hdr.Linkname); !ok || e.hardlink == "" {
+				continue
+			}
+		case tar.TypeSymlink:
+			e.target = []byte(hdr.Linkname)
+			e.attr.Size = uint64(len(e.target))
+		case tar.TypeChar, tar.TypeBlock:
+			e.attr.Rdev = mkdev(hdr.Devmajor, hdr.Devminor)
+		case tar.TypeReg, tar.TypeRegA, tar.TypeGNUSparse:
+			e.attr.Size = uint64(hdr.Size)
+			if isSparse(hdr) {
+				// Sparse files are rare, keep them in memory.
+				data, err := io.ReadAll(tr)
+				if err != nil {
+					return nil, err
+				}
+				e.content = bytes.NewReader(data)
+				e.attr.Size = uint64(len(data))
+			} else {
+				e.content = content(cr.n, hdr.Size)
+			}
+		case tar.TypeDir, tar.TypeFifo:
+		default:
+			continue
+		}
+		if e.attr.Mode&syscall.S_IFMT == syscall.S_IFDIR {
+			e.attr.Nlink = 2
+		}
+		entries = append(entries, e)
+	}
+}
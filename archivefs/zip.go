@@ -0,0 +1,90 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package archivefs
+
+import (
+	"archive/zip"
+	"io"
+	"strings"
+	"syscall"
+)
+
+func indexZip(r io.ReaderAt, size int64) ([]*entry, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*entry, 0, len(zr.File))
+	for _, f := range zr.File {
+		name, ok := cleanName(f.Name)
+		if !ok {
+			continue
+		}
+
+		e := &entry{name: name}
+		e.attr.Mode = fileMode(f.Mode())
+		if strings.HasSuffix(f.Name, "/") {
+			e.attr.Mode = e.attr.Mode&^syscall.S_IFMT | syscall.S_IFDIR
+		}
+		e.attr.Nlink = 1
+		e.attr.SetTimes(&f.Modified, &f.Modified, &f.Modified)
+
+		switch e.attr.Mode & syscall.S_IFMT {
+		case syscall.S_IFDIR:
+			e.attr.Nlink = 2
+		case syscall.S_IFLNK:
+			rc, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			e.target, err = io.ReadAll(rc)
+			rc.Close()
+			if err != nil {
+				return nil, err
+			}
+			e.attr.Size = uint64(len(e.target))
+		case syscall.S_IFREG:
+			e.attr.Size = f.UncompressedSize64
+			if e.content, err = zipContent(r, f); err != nil {
+				return nil, err
+			}
+		default:
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// zipContent reads stored files straight from the archive, compressed
+// ones are decompressed from their start.
+func zipContent(r io.ReaderAt, f *zip.File) (io.ReaderAt, error) {
+	if f.Method == zip.Store {
+		off, err := f.DataOffset()
+		if err != nil {
+			return nil, err
+		}
+		return io.NewSectionReader(r, off, int64(f.UncompressedSize64)), nil
+	}
+	return &streamContent{
+		size: int64(f.UncompressedSize64),
+		open: func(int64) (io.Reader, int64, error) {
+			rc, err := f.Open()
+			return rc, 0, err
+		},
+	}, nil
+}
@@ -0,0 +1,211 @@
+package auth_test
+
+import (
+	"context"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/auth"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+const testTokens = `
+# comment
+rotoken ro
+rwtoken rw
+hometoken rw home
+`
+
+func withToken(t *testing.T, token string) context.Context {
+	md, err := auth.TokenCredentials(token, false).GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	return metadata.NewIncomingContext(context.Background(), metadata.New(md))
+}
+
+func TestParseTokens(t *testing.T) {
+	tokens, err := auth.ParseTokens(strings.NewReader(testTokens))
+	require.NoError(t, err)
+	require.Equal(t, 3, tokens.Len())
+
+	access, ok := tokens.Lookup("rotoken")
+	require.True(t, ok)
+	require.Equal(t, auth.ReadOnly, access)
+	access, ok = tokens.Lookup("rwtoken")
+	require.True(t, ok)
+	require.Equal(t, auth.ReadWrite, access)
+	_, ok = tokens.Lookup("unknown")
+	require.False(t, ok)
+
+	_, err = auth.ParseTokens(strings.NewReader("token"))
+	require.Error(t, err)
+	_, err = auth.ParseTokens(strings.NewReader("token admin"))
+	require.Error(t, err)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	tokens, err := auth.ParseTokens(strings.NewReader(testTokens))
+	require.NoError(t, err)
+	interceptor := auth.UnaryServerInterceptor(tokens)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.WriteResponse{Status: &pb.Status{Code: 0}}, nil
+	}
+
+	testcases := []struct {
+		token  string
+		method string
+		req    interface{}
+		code   codes.Code
+		errno  int32
+	}{
+		{"", "Write", &pb.WriteRequest{}, codes.Unauthenticated, 0},
+		{"unknown", "Write", &pb.WriteRequest{}, codes.Unauthenticated, 0},
+		{"rwtoken", "Write", &pb.WriteRequest{}, codes.OK, 0},
+		{"rotoken", "Write", &pb.WriteRequest{}, codes.OK, int32(syscall.EROFS)},
+		{"rotoken", "GetAttr", &pb.GetAttrRequest{}, codes.OK, 0},
+		{"rotoken", "Open", &pb.OpenRequest{OpenIn: &pb.OpenIn{Flags: syscall.O_RDONLY}}, codes.OK, 0},
+		{"rotoken", "Open", &pb.OpenRequest{OpenIn: &pb.OpenIn{Flags: syscall.O_RDWR}}, codes.OK, int32(syscall.EROFS)},
+	}
+
+	for _, testcase := range testcases {
+		ctx := context.Background()
+		if testcase.token != "" {
+			ctx = withToken(t, testcase.token)
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: "/pb.RawFileSystem/" + testcase.method}
+
+		res, err := interceptor(ctx, testcase.req, info, handler)
+		require.Equal(t, testcase.code, status.Code(err), testcase)
+		if err != nil {
+			continue
+		}
+		require.Equal(t, testcase.errno, res.(interface{ GetStatus() *pb.Status }).GetStatus().GetCode(), testcase)
+	}
+}
+
+func TestInitKernel(t *testing.T) {
+	tokens, err := auth.ParseTokens(strings.NewReader(testTokens))
+	require.NoError(t, err)
+	interceptor := auth.UnaryServerInterceptor(tokens)
+
+	var got *pb.InitRequest
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = req.(*pb.InitRequest)
+		return &pb.InitResponse{}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.RawFileSystem/Init"}
+	req := &pb.InitRequest{
+		Major:        utils.ProtocolMajor,
+		Kernel:       &pb.KernelSettings{Major: 7, Minor: 31},
+		MountOptions: &pb.MountOptions{MaxWrite: 1 << 17},
+	}
+
+	_, err = interceptor(withToken(t, "rwtoken"), req, info, handler)
+	require.NoError(t, err)
+	require.NotNil(t, got.Kernel)
+	require.NotNil(t, got.MountOptions)
+
+	// read-only tokens may initialize, but not configure the file system
+	_, err = interceptor(withToken(t, "rotoken"), req, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, uint32(utils.ProtocolMajor), got.Major)
+	require.Nil(t, got.Kernel)
+	require.Nil(t, got.MountOptions)
+	require.NotNil(t, req.Kernel)
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	tokens, err := auth.ParseTokens(strings.NewReader(testTokens))
+	require.NoError(t, err)
+	interceptor := auth.StreamServerInterceptor(tokens)
+
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		access, ok := auth.FromContext(stream.Context())
+		require.True(t, ok)
+		require.Equal(t, auth.ReadWrite, access)
+		return nil
+	}
+	info := &grpc.StreamServerInfo{FullMethod: "/pb.RawFileSystem/WriteStream"}
+
+	err = interceptor(nil, &testStream{ctx: withToken(t, "rwtoken")}, info, handler)
+	require.NoError(t, err)
+
+	err = interceptor(nil, &testStream{ctx: withToken(t, "rotoken")}, info, handler)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	errno, ok := utils.ErrnoFromStatus(status.Convert(err))
+	require.True(t, ok)
+	require.Equal(t, syscall.EROFS, errno)
+}
+
+func TestExports(t *testing.T) {
+	tokens, err := auth.ParseTokens(strings.NewReader(testTokens))
+	require.NoError(t, err)
+	unary := auth.UnaryServerInterceptor(tokens)
+	stream := auth.StreamServerInterceptor(tokens)
+
+	withExport := func(token, export string) context.Context {
+		ctx := withToken(t, token)
+		if export != "" {
+			md, _ := metadata.FromIncomingContext(ctx)
+			md = metadata.Join(md, metadata.Pairs(utils.ExportKey, export))
+			ctx = metadata.NewIncomingContext(ctx, md)
+		}
+		return ctx
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.GetAttrResponse{Status: &pb.Status{}}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.RawFileSystem/GetAttr"}
+	streamHandler := func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	}
+	streamInfo := &grpc.StreamServerInfo{FullMethod: "/pb.RawFileSystem/Read"}
+
+	testcases := []struct {
+		token, export string
+		code          codes.Code
+	}{
+		{"rwtoken", "", codes.OK},
+		{"rwtoken", "data", codes.OK},
+		{"hometoken", "home", codes.OK},
+		{"hometoken", "data", codes.PermissionDenied},
+		// the default export is some other export
+		{"hometoken", "", codes.PermissionDenied},
+	}
+	for _, testcase := range testcases {
+		ctx := withExport(testcase.token, testcase.export)
+		_, err := unary(ctx, &pb.GetAttrRequest{}, info, handler)
+		require.Equal(t, testcase.code, status.Code(err), testcase)
+		err = stream(nil, &testStream{ctx: ctx}, streamInfo, streamHandler)
+		require.Equal(t, testcase.code, status.Code(err), testcase)
+	}
+
+	// tokens granted some exports only list those
+	list := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.ListExportsResponse{Exports: []string{"data", "home"}}, nil
+	}
+	listInfo := &grpc.UnaryServerInfo{FullMethod: "/pb.RawFileSystem/ListExports"}
+	res, err := unary(withExport("hometoken", ""), &pb.ListExportsRequest{}, listInfo, list)
+	require.NoError(t, err)
+	require.Equal(t, []string{"home"}, res.(*pb.ListExportsResponse).Exports)
+	res, err = unary(withExport("rwtoken", ""), &pb.ListExportsRequest{}, listInfo, list)
+	require.NoError(t, err)
+	require.Equal(t, []string{"data", "home"}, res.(*pb.ListExportsResponse).Exports)
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
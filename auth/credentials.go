@@ -0,0 +1,49 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type tokenCredentials struct {
+	token  string
+	secure bool
+}
+
+// TokenCredentials returns the per-RPC credentials sending token to the
+// server. With requireTransportSecurity the token is never sent over
+// plaintext connections.
+func TokenCredentials(token string, requireTransportSecurity bool) credentials.PerRPCCredentials {
+	return &tokenCredentials{token: token, secure: requireTransportSecurity}
+}
+
+func (c *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{authorizationKey: bearerPrefix + c.token}, nil
+}
+
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return c.secure
+}
+
+// WithToken is the dial option sending token with every request.
+func WithToken(token string, requireTransportSecurity bool) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(TokenCredentials(token, requireTransportSecurity))
+}
@@ -0,0 +1,246 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"context"
+	"strings"
+	"syscall"
+
+	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+const (
+	// authorizationKey is the metadata key the token is sent under.
+	authorizationKey = "authorization"
+	bearerPrefix     = "Bearer "
+
+	servicePrefix = "/pb.RawFileSystem/"
+)
+
+// mutating are the requests which modify the file system.
+var mutating = map[string]bool{
+	"SetAttr":       true,
+	"Mknod":         true,
+	"Mkdir":         true,
+	"Unlink":        true,
+	"Rmdir":         true,
+	"Rename":        true,
+	"Link":          true,
+	"Symlink":       true,
+	"SetXAttr":      true,
+	"RemoveXAttr":   true,
+	"Create":        true,
+	"Write":         true,
+	"WriteStream":   true,
+	"CopyFileRange": true,
+	"Fallocate":     true,
+}
+
+type (
+	accessKey  struct{}
+	exportsKey struct{}
+)
+
+// FromContext returns the access of the client calling with ctx.
+func FromContext(ctx context.Context) (Access, bool) {
+	access, ok := ctx.Value(accessKey{}).(Access)
+	return access, ok
+}
+
+// authenticate checks the token of ctx against tokens.
+func authenticate(ctx context.Context, tokens *Tokens) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationKey)
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+	if !strings.HasPrefix(values[0], bearerPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "not a bearer token")
+	}
+
+	g, ok := tokens.lookup(strings.TrimPrefix(values[0], bearerPrefix))
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	ctx = context.WithValue(ctx, accessKey{}, g.access)
+	return context.WithValue(ctx, exportsKey{}, g.exports), nil
+}
+
+// checkExport fails if the token of ctx may not call method on the
+// export selected by ctx. ListExports is left to filterExports.
+func checkExport(ctx context.Context, method string) error {
+	exports, _ := ctx.Value(exportsKey{}).(map[string]bool)
+	if exports == nil || !strings.HasPrefix(method, servicePrefix) || method == servicePrefix+"ListExports" {
+		return nil
+	}
+	name := utils.FromIncomingContext(ctx, utils.ExportKey)
+	if !exports[name] {
+		return status.Errorf(codes.PermissionDenied, "export %q not granted to token", name)
+	}
+	return nil
+}
+
+// filterExports drops the exports the token of ctx is not granted from
+// the response of ListExports.
+func filterExports(ctx context.Context, res interface{}) interface{} {
+	exports, _ := ctx.Value(exportsKey{}).(map[string]bool)
+	list, ok := res.(*pb.ListExportsResponse)
+	if exports == nil || !ok {
+		return res
+	}
+	filtered := &pb.ListExportsResponse{}
+	for _, name := range list.Exports {
+		if exports[name] {
+			filtered.Exports = append(filtered.Exports, name)
+		}
+	}
+	return filtered
+}
+
+// allowed reports whether access may call method with req, req is nil
+// for streams.
+func allowed(access Access, method string, req interface{}) bool {
+	if access == ReadWrite || !strings.HasPrefix(method, servicePrefix) {
+		return true
+	}
+
+	name := strings.TrimPrefix(method, servicePrefix)
+	if mutating[name] {
+		return false
+	}
+	if name == "Open" {
+		// opening for writing or truncating is not read-only either
+		if req, ok := req.(*pb.OpenRequest); ok && req.OpenIn != nil {
+			flags := req.OpenIn.Flags
+			return flags&syscall.O_ACCMODE == syscall.O_RDONLY && flags&syscall.O_TRUNC == 0
+		}
+	}
+	return true
+}
+
+// readOnlyRequest strips what read-only tokens may not pass on from
+// req. The kernel settings and mount options of Init reach the file
+// system, which applies them for every client of the export.
+func readOnlyRequest(access Access, method string, req interface{}) interface{} {
+	init, ok := req.(*pb.InitRequest)
+	if access == ReadWrite || method != servicePrefix+"Init" || !ok || (init.Kernel == nil && init.MountOptions == nil) {
+		return req
+	}
+	init = proto.Clone(init).(*pb.InitRequest)
+	init.Kernel = nil
+	init.MountOptions = nil
+	return init
+}
+
+func readOnlyError(method string) error {
+	return utils.ErrnoError(codes.PermissionDenied, syscall.EROFS, method+": read-only token")
+}
+
+// readOnlyResponse returns the response of method failing with EROFS.
+// Responses without a status, like the one of Forget, get
+// codes.PermissionDenied carrying EROFS instead.
+func readOnlyResponse(method string) (interface{}, error) {
+	err := readOnlyError(method)
+
+	name := strings.TrimPrefix(method, servicePrefix)
+	sd := pb.File_raw_file_system_proto.Services().ByName("RawFileSystem")
+	md := sd.Methods().ByName(protoreflect.Name(name))
+	if md == nil {
+		return nil, err
+	}
+	mt, e := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if e != nil {
+		return nil, err
+	}
+
+	res := mt.New()
+	fd := res.Descriptor().Fields().ByName("status")
+	if fd == nil || fd.Message() == nil || fd.Message().FullName() != "pb.Status" {
+		return nil, err
+	}
+	res.Set(fd, protoreflect.ValueOfMessage((&pb.Status{Code: int32(syscall.EROFS)}).ProtoReflect()))
+	return proto.Message(res.Interface()), nil
+}
+
+// UnaryServerInterceptor rejects requests without a known token, and
+// requests for exports the token is not granted with
+// codes.PermissionDenied. Requests of read-only tokens which would
+// modify the file system fail with EROFS, their Init requests lose the
+// kernel settings. ListExports only lists the exports of the token.
+func UnaryServerInterceptor(tokens *Tokens) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, tokens)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkExport(ctx, info.FullMethod); err != nil {
+			grpc_logrus.Extract(ctx).Warnf("%s: %v", info.FullMethod, err)
+			return nil, err
+		}
+		access, _ := FromContext(ctx)
+		if !allowed(access, info.FullMethod, req) {
+			grpc_logrus.Extract(ctx).Warnf("%s denied for read-only token", info.FullMethod)
+			return readOnlyResponse(info.FullMethod)
+		}
+		res, err := handler(ctx, readOnlyRequest(access, info.FullMethod, req))
+		if err != nil {
+			return res, err
+		}
+		return filterExports(ctx, res), nil
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streams.
+// Mutating streams of read-only tokens fail with
+// codes.PermissionDenied carrying EROFS.
+func StreamServerInterceptor(tokens *Tokens) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), tokens)
+		if err != nil {
+			return err
+		}
+		if err := checkExport(ctx, info.FullMethod); err != nil {
+			grpc_logrus.Extract(ctx).Warnf("%s: %v", info.FullMethod, err)
+			return err
+		}
+		if access, _ := FromContext(ctx); !allowed(access, info.FullMethod, nil) {
+			grpc_logrus.Extract(ctx).Warnf("%s denied for read-only token", info.FullMethod)
+			return readOnlyError(info.FullMethod)
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package auth authenticates the clients of a fuse2grpc server with
+// bearer tokens, limits read-only tokens to requests which do not
+// modify the file system, and tokens granted some exports to those.
+package auth
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Access is what a token is allowed to do.
+type Access int
+
+const (
+	// ReadOnly tokens get EROFS for requests modifying the file system.
+	ReadOnly Access = iota
+	// ReadWrite tokens may do anything.
+	ReadWrite
+)
+
+func (a Access) String() string {
+	switch a {
+	case ReadOnly:
+		return "ro"
+	case ReadWrite:
+		return "rw"
+	}
+	return fmt.Sprintf("Access(%d)", int(a))
+}
+
+// grant is what a token is allowed to do, and where.
+type grant struct {
+	access Access
+	// exports are the names of the exports the token may select, nil
+	// for all of them.
+	exports map[string]bool
+}
+
+// Tokens maps the hashes of the known tokens to their access.
+type Tokens struct {
+	grants map[[sha256.Size]byte]grant
+}
+
+// NewTokens returns an empty token set.
+func NewTokens() *Tokens {
+	return &Tokens{grants: make(map[[sha256.Size]byte]grant)}
+}
+
+// Add grants token access to exports, to all exports if none is given.
+// Tokens limited to exports can not use the default export, their
+// clients have to select one by name.
+func (t *Tokens) Add(token string, access Access, exports ...string) {
+	g := grant{access: access}
+	if len(exports) > 0 {
+		g.exports = make(map[string]bool, len(exports))
+		for _, name := range exports {
+			g.exports[name] = true
+		}
+	}
+	t.grants[sha256.Sum256([]byte(token))] = g
+}
+
+// Lookup returns the access of token.
+func (t *Tokens) Lookup(token string) (Access, bool) {
+	g, ok := t.lookup(token)
+	return g.access, ok
+}
+
+func (t *Tokens) lookup(token string) (grant, bool) {
+	// Tokens are only kept hashed, so looking them up does not leak
+	// how much of a guess is right.
+	g, ok := t.grants[sha256.Sum256([]byte(token))]
+	return g, ok
+}
+
+// Len returns the number of tokens.
+func (t *Tokens) Len() int {
+	return len(t.grants)
+}
+
+// ParseTokens reads a token file. Every line holds a token followed by
+// its access, "ro" or "rw", and optionally the names of the exports it
+// is limited to. Empty lines and lines starting with # are skipped:
+//
+//	# build machines
+//	3f0a9c1e7d ro
+//	b72e4410aa rw
+//	# the home directories only
+//	95c0d7e213 rw home
+func ParseTokens(r io.Reader) (*Tokens, error) {
+	tokens := NewTokens()
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: want \"<token> ro|rw [export...]\"", line)
+		}
+		switch fields[1] {
+		case "ro":
+			tokens.Add(fields[0], ReadOnly, fields[2:]...)
+		case "rw":
+			tokens.Add(fields[0], ReadWrite, fields[2:]...)
+		default:
+			return nil, fmt.Errorf("line %d: unknown access %q", line, fields[1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// LoadTokens reads the token file name.
+func LoadTokens(name string) (*Tokens, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	tokens, err := ParseTokens(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return tokens, nil
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compress
+
+import (
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+)
+
+type zstdEncoder struct{ *zstd.Encoder }
+
+func (e zstdEncoder) reset(w io.Writer) { e.Reset(w) }
+
+func newZstdEncoder(w io.Writer) (encoder, error) {
+	// a single goroutine per message, messages are compressed
+	// concurrently anyway
+	enc, err := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1), zstd.WithEncoderLevel(zstd.SpeedFastest))
+	if err != nil {
+		return nil, err
+	}
+	return zstdEncoder{enc}, nil
+}
+
+type zstdDecoder struct{ *zstd.Decoder }
+
+func (d zstdDecoder) reset(r io.Reader) error { return d.Reset(r) }
+
+func newZstdDecoder(r io.Reader) (decoder, error) {
+	// decoding synchronously, no goroutines are left behind by the
+	// pooled decoders
+	dec, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return zstdDecoder{dec}, nil
+}
+
+type snappyEncoder struct{ *snappy.Writer }
+
+func (e snappyEncoder) reset(w io.Writer) { e.Reset(w) }
+
+func newSnappyEncoder(w io.Writer) (encoder, error) {
+	return snappyEncoder{snappy.NewBufferedWriter(w)}, nil
+}
+
+type snappyDecoder struct{ *snappy.Reader }
+
+func (d snappyDecoder) reset(r io.Reader) error {
+	d.Reset(r)
+	return nil
+}
+
+func newSnappyDecoder(r io.Reader) (decoder, error) {
+	return snappyDecoder{snappy.NewReader(r)}, nil
+}
+
+type gzipEncoder struct{ *gzip.Writer }
+
+func (e gzipEncoder) reset(w io.Writer) { e.Reset(w) }
+
+func newGzipEncoder(w io.Writer) (encoder, error) {
+	enc, err := gzip.NewWriterLevel(w, gzip.BestSpeed)
+	if err != nil {
+		return nil, err
+	}
+	return gzipEncoder{enc}, nil
+}
+
+type gzipDecoder struct{ *gzip.Reader }
+
+func (d gzipDecoder) reset(r io.Reader) error { return d.Reset(r) }
+
+func newGzipDecoder(r io.Reader) (decoder, error) {
+	dec, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return gzipDecoder{dec}, nil
+}
@@ -0,0 +1,207 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package compress registers the compressors grpcfuse clients and
+// servers negotiate for their payloads with the gRPC encoding registry,
+// and exports the compression ratio as Prometheus metrics.
+//
+// Importing the package registers zstd, snappy and gzip, the latter
+// replacing the gzip compressor of grpc.
+package compress
+
+import (
+	"io"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/encoding"
+)
+
+const (
+	Zstd   = "zstd"
+	Snappy = "snappy"
+	Gzip   = "gzip"
+)
+
+// Names are the compressors registered by the package, the preferred
+// ones first.
+var Names = []string{Zstd, Snappy, Gzip}
+
+var (
+	bytesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpcfuse_compression_bytes_total",
+		Help: "Bytes of the messages passed through the compressors, by uncompressed and compressed size.",
+	}, []string{"compressor", "direction", "size"})
+
+	ratio = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpcfuse_compression_ratio",
+		Help:    "Uncompressed size divided by compressed size of the messages.",
+		Buckets: []float64{0.5, 1, 1.25, 1.5, 2, 3, 4, 6, 8, 16},
+	}, []string{"compressor", "direction"})
+)
+
+func init() {
+	prometheus.MustRegister(bytesTotal, ratio)
+
+	encoding.RegisterCompressor(&compressor{name: Zstd, newEncoder: newZstdEncoder, newDecoder: newZstdDecoder})
+	encoding.RegisterCompressor(&compressor{name: Snappy, newEncoder: newSnappyEncoder, newDecoder: newSnappyDecoder})
+	encoding.RegisterCompressor(&compressor{name: Gzip, newEncoder: newGzipEncoder, newDecoder: newGzipDecoder})
+}
+
+// Registered reports whether name is one of Names.
+func Registered(name string) bool {
+	for _, n := range Names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// encoder is a compressing writer which can be reused for another
+// message.
+type encoder interface {
+	io.WriteCloser
+	reset(w io.Writer)
+}
+
+// decoder is a decompressing reader which can be reused for another
+// message.
+type decoder interface {
+	io.Reader
+	reset(r io.Reader) error
+}
+
+// compressor is an encoding.Compressor pooling its encoders and
+// decoders.
+type compressor struct {
+	name       string
+	newEncoder func(w io.Writer) (encoder, error)
+	newDecoder func(r io.Reader) (decoder, error)
+
+	encoders sync.Pool
+	decoders sync.Pool
+}
+
+func (c *compressor) Name() string {
+	return c.name
+}
+
+func (c *compressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	out := &countingWriter{w: w}
+	enc, ok := c.encoders.Get().(encoder)
+	if ok {
+		enc.reset(out)
+	} else {
+		var err error
+		if enc, err = c.newEncoder(out); err != nil {
+			return nil, err
+		}
+	}
+	return &compressWriter{c: c, enc: enc, out: out}, nil
+}
+
+func (c *compressor) Decompress(r io.Reader) (io.Reader, error) {
+	in := &countingReader{r: r}
+	dec, ok := c.decoders.Get().(decoder)
+	if ok {
+		if err := dec.reset(in); err != nil {
+			c.decoders.Put(dec)
+			return nil, err
+		}
+	} else {
+		var err error
+		if dec, err = c.newDecoder(in); err != nil {
+			return nil, err
+		}
+	}
+	return &decompressReader{c: c, dec: dec, in: in}, nil
+}
+
+// observe records a message of raw bytes compressed to compressed
+// bytes.
+func (c *compressor) observe(direction string, raw, compressed int64) {
+	bytesTotal.WithLabelValues(c.name, direction, "uncompressed").Add(float64(raw))
+	bytesTotal.WithLabelValues(c.name, direction, "compressed").Add(float64(compressed))
+	if compressed > 0 {
+		ratio.WithLabelValues(c.name, direction).Observe(float64(raw) / float64(compressed))
+	}
+}
+
+type compressWriter struct {
+	c   *compressor
+	enc encoder
+	out *countingWriter
+	n   int64
+}
+
+func (w *compressWriter) Write(p []byte) (int, error) {
+	n, err := w.enc.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+func (w *compressWriter) Close() error {
+	err := w.enc.Close()
+	w.c.encoders.Put(w.enc)
+	w.c.observe("compress", w.n, w.out.n)
+	return err
+}
+
+type decompressReader struct {
+	c    *compressor
+	dec  decoder
+	in   *countingReader
+	n    int64
+	done bool
+}
+
+func (r *decompressReader) Read(p []byte) (int, error) {
+	if r.done {
+		return 0, io.EOF
+	}
+	n, err := r.dec.Read(p)
+	r.n += int64(n)
+	if err == io.EOF {
+		// the decoder goes back to the pool once the message is read
+		r.done = true
+		r.c.decoders.Put(r.dec)
+		r.c.observe("decompress", r.n, r.in.n)
+	}
+	return n, err
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,79 @@
+package compress_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+
+	"github.com/chiyutianyi/grpcfuse/compress"
+)
+
+func TestCompressors(t *testing.T) {
+	data := []byte(strings.Repeat("the quick brown fox jumps over the lazy dog\n", 1000))
+
+	for _, name := range compress.Names {
+		c := encoding.GetCompressor(name)
+		require.NotNil(t, c, name)
+		require.Equal(t, name, c.Name())
+
+		// the second round uses the pooled encoder and decoder
+		for i := 0; i < 2; i++ {
+			var buf bytes.Buffer
+			w, err := c.Compress(&buf)
+			require.NoError(t, err, name)
+			_, err = w.Write(data)
+			require.NoError(t, err, name)
+			require.NoError(t, w.Close(), name)
+			require.Less(t, buf.Len(), len(data), name)
+
+			r, err := c.Decompress(&buf)
+			require.NoError(t, err, name)
+			out, err := ioutil.ReadAll(r)
+			require.NoError(t, err, name)
+			require.Equal(t, data, out, name)
+		}
+	}
+
+	require.True(t, compress.Registered(compress.Zstd))
+	require.False(t, compress.Registered("lz4"))
+}
+
+// uncompressedBytes returns the bytes compressed by name so far.
+func uncompressedBytes(t *testing.T, name string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != "grpcfuse_compression_bytes_total" {
+			continue
+		}
+		for _, m := range family.Metric {
+			labels := make(map[string]string)
+			for _, l := range m.Label {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels["compressor"] == name && labels["direction"] == "compress" && labels["size"] == "uncompressed" {
+				return m.Counter.GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func TestMetrics(t *testing.T) {
+	before := uncompressedBytes(t, compress.Snappy)
+	c := encoding.GetCompressor(compress.Snappy)
+
+	var buf bytes.Buffer
+	w, err := c.Compress(&buf)
+	require.NoError(t, err)
+	_, err = w.Write(make([]byte, 1<<16))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	require.Equal(t, before+1<<16, uncompressedBytes(t, compress.Snappy))
+}
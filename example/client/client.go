@@ -17,19 +17,28 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"io/ioutil"
 	"os"
 	"os/signal"
 	"path"
 	"runtime"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/hanwen/go-fuse/v2/fuse"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/chiyutianyi/grpcfuse/auth"
 	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
 	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/tlsutil"
 	"github.com/chiyutianyi/grpcfuse/pkg/utils"
 )
 
@@ -37,7 +46,21 @@ func main() {
 	debug := flag.Bool("debug", false, "print debugging messages.")
 	other := flag.Bool("allow-other", false, "mount with -o allowother.")
 	ro := flag.Bool("ro", false, "mount read-only")
+	cache := flag.Bool("cache", false, "cache attributes and entries on the client")
+	readahead := flag.Int("readahead", 0, "prefetch this many 1MB windows of files read sequentially, 0 disables readahead")
+	compressor := flag.String("compress", "", "compress read and written data with zstd, snappy or gzip if the server supports it")
+	compressThreshold := flag.Int("compress-threshold", 0, "do not compress requests carrying or returning less bytes, 0 means 4KB")
+	checksumType := flag.String("checksum", "", "protect read and written data with crc32c or xxhash checksums if the server supports them")
+	writeBack := flag.Bool("write-back", false, "buffer writes and send them in the background, errors are reported on close and fsync")
+	maxWrite := flag.Int("max-write", 1<<20, "max size of a single write from the kernel")
+	timeout := flag.Duration("timeout", time.Minute, "timeout of requests without a timeout of their own, 0 waits forever")
+	retry := flag.Bool("retry", false, "retry idempotent requests while the server is unavailable")
+	export := flag.String("export", "", "export to mount, the default export of the server if empty")
+	clientID := flag.String("client-id", "", "id the server keeps the handles of this mount under, random by default")
 	loggerLevel := flag.String("logger-level", "info", "log level")
+	tokenFile := flag.String("token-file", "", "file holding the token to authenticate with")
+	var tlsConfig tlsutil.Config
+	tlsConfig.AddFlags(flag.CommandLine)
 	flag.Parse()
 	if flag.NArg() < 2 {
 		log.Fatalf("Usage: %s <mountpath> <fuseserver>", path.Base(os.Args[0]))
@@ -47,13 +70,37 @@ func main() {
 	mp := flag.Arg(0)
 	fuseServer := flag.Arg(1)
 
-	dialOpts := []grpc.DialOption{grpc.WithInsecure()}
+	creds, err := tlsConfig.DialOption()
+	if err != nil {
+		log.Fatal(err)
+	}
+	dialOpts := []grpc.DialOption{creds}
+	if *tokenFile != "" {
+		token, err := ioutil.ReadFile(*tokenFile)
+		if err != nil {
+			log.Fatalf("Read token: %v", err)
+		}
+		if !tlsConfig.Active() {
+			log.Warnf("Sending the token in plaintext to %s, pass -tls to protect it", fuseServer)
+		}
+		// with TLS, grpc never sends the token over a plaintext connection
+		dialOpts = append(dialOpts, auth.WithToken(strings.TrimSpace(string(token)), tlsConfig.Active()))
+	}
+	if *retry {
+		// reconnect quickly after the server restarts
+		bc := backoff.DefaultConfig
+		bc.MaxDelay = grpc2fuse.DefaultRetryPolicy.MaxBackoff
+		dialOpts = append(dialOpts, grpc.WithConnectParams(grpc.ConnectParams{Backoff: bc}))
+	}
 	conn, err := grpc.Dial(fuseServer, dialOpts...)
 	if err != nil {
 		log.Fatal(err)
 	}
 	cli := pb.NewRawFileSystemClient(conn)
-	fs := grpc2fuse.NewFileSystem(cli)
+
+	if *export != "" {
+		checkExport(cli, *export)
+	}
 
 	var opt fuse.MountOptions
 	opt.FsName = "GrpcFS"
@@ -62,7 +109,7 @@ func main() {
 	opt.MaxBackground = 50
 	opt.EnableLocks = true
 	opt.IgnoreSecurityLabels = true
-	opt.MaxWrite = 1 << 20
+	opt.MaxWrite = *maxWrite
 	opt.MaxReadAhead = 1 << 20
 	opt.DirectMount = true
 	opt.AllowOther = *other
@@ -77,23 +124,88 @@ func main() {
 		opt.Options = append(opt.Options, "ro")
 	}
 
+	fsOpts := []grpc.CallOption{
+		grpc2fuse.WithDefaultTimeout(*timeout),
+		grpc2fuse.WithMountOptions(opt),
+	}
+	if *cache {
+		fsOpts = append(fsOpts, grpc2fuse.WithCache(0))
+	}
+	if *readahead > 0 {
+		fsOpts = append(fsOpts, grpc2fuse.WithReadahead(0, *readahead, 0))
+	}
+	if *compressor != "" {
+		fsOpts = append(fsOpts, grpc2fuse.WithCompression(*compressor, *compressThreshold))
+	}
+	switch *checksumType {
+	case "":
+	case "crc32c":
+		fsOpts = append(fsOpts, grpc2fuse.WithChecksum(pb.ChecksumType_CHECKSUM_CRC32C))
+	case "xxhash":
+		fsOpts = append(fsOpts, grpc2fuse.WithChecksum(pb.ChecksumType_CHECKSUM_XXHASH))
+	default:
+		log.Fatalf("Unknown checksum %s", *checksumType)
+	}
+	if *writeBack {
+		fsOpts = append(fsOpts, grpc2fuse.WithWriteBack(0))
+	}
+	if *retry {
+		fsOpts = append(fsOpts, grpc2fuse.WithRetryPolicy(grpc2fuse.DefaultRetryPolicy))
+	}
+	if *clientID != "" {
+		fsOpts = append(fsOpts, grpc2fuse.WithClientID(*clientID))
+	}
+	if *export != "" {
+		fsOpts = append(fsOpts, grpc2fuse.WithExport(*export))
+	}
+	fs := grpc2fuse.NewFileSystem(cli, fsOpts...)
+	if err := fs.Handshake(); err != nil {
+		log.Fatal(err)
+	}
+
 	srv, err := fuse.NewServer(fs, mp, &opt)
 	if err != nil {
 		log.Fatalf("New fuse server: %v", err)
 	}
 
-	go srv.Serve()
+	// Serve returns once the file system is unmounted, by a signal or
+	// by umount, and all requests are done.
+	served := make(chan struct{})
+	go func() {
+		srv.Serve()
+		srv.Wait()
+		close(served)
+	}()
 
 	signal.Ignore(syscall.SIGPIPE)
 	sigCh := make(chan os.Signal, 10)
 	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP)
-	for range sigCh {
-		err := srv.Unmount()
-		if err != nil {
+	select {
+	case <-sigCh:
+		if err := srv.Unmount(); err != nil {
 			log.Fatalf("Unmount: %v", err)
-		} else {
-			log.Info("Unmounted")
+		}
+		<-served
+	case <-served:
+	}
+	// send the writes and Forgets still pending
+	fs.Close()
+	log.Info("Unmounted")
+}
+
+// checkExport fails early if the server does not serve export.
+func checkExport(cli pb.RawFileSystemClient, export string) {
+	res, err := cli.ListExports(context.Background(), &pb.ListExportsRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return
+	}
+	if err != nil {
+		log.Fatalf("ListExports: %v", err)
+	}
+	for _, name := range res.Exports {
+		if name == export {
 			return
 		}
 	}
+	log.Fatalf("Export %q not found, the server exports %q", export, res.Exports)
 }
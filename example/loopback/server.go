@@ -23,6 +23,7 @@ import (
 	"os"
 	"os/signal"
 	"path"
+	"strings"
 	"syscall"
 	"time"
 
@@ -31,11 +32,18 @@ import (
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 
+	"github.com/chiyutianyi/grpcfuse/archivefs"
+	"github.com/chiyutianyi/grpcfuse/auth"
 	"github.com/chiyutianyi/grpcfuse/fuse2grpc"
+	"github.com/chiyutianyi/grpcfuse/idmap"
+	"github.com/chiyutianyi/grpcfuse/memfs"
+	"github.com/chiyutianyi/grpcfuse/overlayfs"
 	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/tlsutil"
 	"github.com/chiyutianyi/grpcfuse/pkg/utils"
 )
 
@@ -45,14 +53,23 @@ func main() {
 	quiet := flag.Bool("q", false, "quiet")
 	ro := flag.Bool("ro", false, "mount read-only")
 	loggerLevel := flag.String("logger-level", "info", "log level")
+	tokenFile := flag.String("token-file", "", "only accept clients with a token listed in this file, one \"<token> ro|rw [export...]\" per line")
+	idmapFile := flag.String("idmap", "", "file mapping client uids and gids to server ones, one \"uid|gid <client> <server>\" per line")
+	squash := flag.String("squash", "none", "replace callers by the anonymous user: none, root or all")
+	anonUID := flag.Uint("anon-uid", idmap.DefaultAnonID, "uid of squashed callers")
+	anonGID := flag.Uint("anon-gid", idmap.DefaultAnonID, "gid of squashed callers")
+	overlay := flag.Bool("overlay", false, "serve LOWER:UPPER arguments as the read-only LOWER overlaid with the writable UPPER")
+	memory := flag.Bool("memory", false, "serve an in-memory file system as export \"memory\", the default export unless directories are given")
+	memorySize := flag.Int64("memory-size", 1<<30, "size cap of the in-memory file system in bytes, 0 for no cap")
+	var tlsConfig tlsutil.Config
+	tlsConfig.AddFlags(flag.CommandLine)
 	flag.Parse()
 
-	if flag.NArg() < 1 {
-		logrus.Fatalf("Usage: %s <ORIGINAL>", path.Base(os.Args[0]))
+	if flag.NArg() < 1 && !*memory {
+		logrus.Fatalf("Usage: %s [-memory] [-overlay] [NAME=]DIR|ARCHIVE|LOWER:UPPER...", path.Base(os.Args[0]))
 	}
 
 	logrus.SetLevel(utils.GetLogLevel(*loggerLevel))
-	orig := flag.Arg(0)
 
 	l, err := net.Listen("tcp", "127.0.0.1:8760")
 	if err != nil {
@@ -62,25 +79,6 @@ func main() {
 	logEntry := logrus.NewEntry(logrus.StandardLogger())
 	grpc_logrus.ReplaceGrpcLogger(logEntry)
 
-	s := grpc.NewServer(
-		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
-			grpc_prometheus.StreamServerInterceptor,
-			grpc_logrus.StreamServerInterceptor(logEntry),
-			grpc_recovery.StreamServerInterceptor(),
-		)),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-			grpc_prometheus.UnaryServerInterceptor,
-			grpc_logrus.UnaryServerInterceptor(logEntry),
-			grpc_recovery.UnaryServerInterceptor(),
-		)),
-	)
-	grpc_prometheus.Register(s)
-
-	loopbackRoot, err := fs.NewLoopbackRoot(orig)
-	if err != nil {
-		logrus.Fatalf("NewLoopbackRoot: %v", err)
-	}
-
 	sec := time.Second
 	opts := &fs.Options{
 		// These options are to be compatible with libfuse defaults,
@@ -97,8 +95,6 @@ func main() {
 	if *ro {
 		opts.MountOptions.Options = append(opts.MountOptions.Options, "ro")
 	}
-	// First column in "df -T": original dir
-	opts.MountOptions.Options = append(opts.MountOptions.Options, "fsname="+orig)
 	// Second column in "df -T" will be shown as "fuse." + Name
 	opts.MountOptions.Name = "loopback"
 	// Leave file permissions on "000" files as-is
@@ -108,14 +104,107 @@ func main() {
 		opts.Logger = log.New(os.Stderr, "", 0)
 	}
 
-	rawFS := fs.NewNodeFS(loopbackRoot, opts)
+	// The first directory is the default export as well, so clients
+	// which do not select an export get it.
+	srv := fuse2grpc.NewServer(nil)
+	for i, arg := range flag.Args() {
+		name, orig := path.Base(arg), arg
+		if n := strings.Index(arg, "="); n >= 0 {
+			name, orig = arg[:n], arg[n+1:]
+		} else if lower, _, ok := strings.Cut(arg, ":"); ok && *overlay {
+			name = path.Base(lower)
+		}
+
+		var rawFS fuse.RawFileSystem
+		if st, err := os.Stat(orig); err == nil && st.Mode().IsRegular() {
+			// Archives are served read-only without extracting them.
+			if rawFS, err = archivefs.New(orig); err != nil {
+				logrus.Fatalf("archivefs.New: %v", err)
+			}
+		} else {
+			var root fs.InodeEmbedder
+			if lower, upper, ok := strings.Cut(orig, ":"); ok && *overlay {
+				if root, err = overlayfs.NewRoot(lower, upper); err != nil {
+					logrus.Fatalf("overlayfs.NewRoot: %v", err)
+				}
+			} else if root, err = fs.NewLoopbackRoot(orig); err != nil {
+				logrus.Fatalf("NewLoopbackRoot: %v", err)
+			}
+			exportOpts := *opts
+			// First column in "df -T": original dir
+			exportOpts.MountOptions.Options = append([]string{"fsname=" + orig}, opts.MountOptions.Options...)
+			rawFS = fs.NewNodeFS(root, &exportOpts)
+		}
+
+		srv.AddExport(name, rawFS)
+		if i == 0 {
+			srv.AddExport(fuse2grpc.DefaultExport, rawFS)
+		}
+		logrus.Infof("Export %s: %s", name, orig)
+	}
+	if *memory {
+		rawFS := memfs.New(memfs.Options{
+			MaxBytes: *memorySize,
+			Owner:    fuse.Owner{Uid: uint32(os.Getuid()), Gid: uint32(os.Getgid())},
+		})
+		srv.AddExport("memory", rawFS)
+		if flag.NArg() == 0 {
+			srv.AddExport(fuse2grpc.DefaultExport, rawFS)
+		}
+		logrus.Infof("Export memory: %d bytes", *memorySize)
+	}
 
-	srv := fuse2grpc.NewServer(rawFS)
+	creds, err := tlsConfig.ServerOption()
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpc_prometheus.StreamServerInterceptor,
+		grpc_logrus.StreamServerInterceptor(logEntry),
+		grpc_recovery.StreamServerInterceptor(),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpc_prometheus.UnaryServerInterceptor,
+		grpc_logrus.UnaryServerInterceptor(logEntry),
+		grpc_recovery.UnaryServerInterceptor(),
+	}
+	if *tokenFile != "" {
+		tokens, err := auth.LoadTokens(*tokenFile)
+		if err != nil {
+			logrus.Fatalf("LoadTokens: %v", err)
+		}
+		logrus.Infof("Loaded %d tokens", tokens.Len())
+		streamInterceptors = append(streamInterceptors, auth.StreamServerInterceptor(tokens))
+		unaryInterceptors = append(unaryInterceptors, auth.UnaryServerInterceptor(tokens))
+	}
+	if *idmapFile != "" || *squash != "none" {
+		mapper := idmap.NewMapper()
+		if *idmapFile != "" {
+			if mapper, err = idmap.LoadMap(*idmapFile); err != nil {
+				logrus.Fatalf("LoadMap: %v", err)
+			}
+		}
+		if mapper.Squash, err = idmap.ParseSquash(*squash); err != nil {
+			logrus.Fatal(err)
+		}
+		mapper.AnonUID, mapper.AnonGID = uint32(*anonUID), uint32(*anonGID)
+		streamInterceptors = append(streamInterceptors, idmap.StreamServerInterceptor(mapper))
+		unaryInterceptors = append(unaryInterceptors, idmap.UnaryServerInterceptor(mapper))
+	}
+
+	s := grpc.NewServer(
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
+		grpc.StatsHandler(srv.StatsHandler()),
+		creds,
+	)
+	grpc_prometheus.Register(s)
 
 	pb.RegisterRawFileSystemServer(s, srv)
 	go s.Serve(l)
 
-	logrus.Infof("Listen on %s for dir %s", l.Addr(), orig)
+	logrus.Infof("Listen on %s", l.Addr())
 
 	signal.Ignore(syscall.SIGPIPE)
 	sigCh := make(chan os.Signal, 10)
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/webdav"
+	"google.golang.org/grpc"
+
+	"github.com/chiyutianyi/grpcfuse/auth"
+	"github.com/chiyutianyi/grpcfuse/grpcfs"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/tlsutil"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+	"github.com/chiyutianyi/grpcfuse/webdavfs"
+)
+
+func main() {
+	listen := flag.String("listen", "127.0.0.1:8761", "address to serve WebDAV on")
+	prefix := flag.String("prefix", "", "URL path prefix to strip from WebDAV requests")
+	export := flag.String("export", "", "export to serve, the default export of the server if empty")
+	loggerLevel := flag.String("logger-level", "info", "log level")
+	tokenFile := flag.String("token-file", "", "file holding the token to authenticate with")
+	readOnly := flag.Bool("read-only", false, "reject every WebDAV request modifying the export, anyone reaching -listen may otherwise write to it")
+	var tlsConfig, httpTLSConfig tlsutil.Config
+	tlsConfig.AddFlags(flag.CommandLine)
+	// -http-tls-ca requires client certificates, the only authentication
+	// of the WebDAV clients.
+	httpTLSConfig.AddPrefixedFlags(flag.CommandLine, "http-")
+	flag.Parse()
+	if flag.NArg() < 1 {
+		log.Fatalf("Usage: %s <fuseserver>", path.Base(os.Args[0]))
+	}
+
+	log.SetLevel(utils.GetLogLevel(*loggerLevel))
+
+	creds, err := tlsConfig.DialOption()
+	if err != nil {
+		log.Fatal(err)
+	}
+	dialOpts := []grpc.DialOption{creds}
+	if *tokenFile != "" {
+		token, err := ioutil.ReadFile(*tokenFile)
+		if err != nil {
+			log.Fatalf("Read token: %v", err)
+		}
+		if !tlsConfig.Active() {
+			log.Warnf("Sending the token in plaintext to %s, pass -tls to protect it", flag.Arg(0))
+		}
+		// with TLS, grpc never sends the token over a plaintext connection
+		dialOpts = append(dialOpts, auth.WithToken(strings.TrimSpace(string(token)), tlsConfig.Active()))
+	}
+	conn, err := grpc.Dial(flag.Arg(0), dialOpts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer conn.Close()
+
+	fsys := grpcfs.New(pb.NewRawFileSystemClient(conn), grpcfs.WithExport(*export))
+	if _, err := fsys.Stat("."); err != nil {
+		log.Fatalf("Export %q: %v", *export, err)
+	}
+
+	var handler http.Handler = &webdav.Handler{
+		Prefix:     *prefix,
+		FileSystem: webdavfs.New(fsys),
+		LockSystem: webdavfs.NewLockSystem(fsys),
+		Logger: func(r *http.Request, err error) {
+			entry := log.WithFields(log.Fields{"method": r.Method, "path": r.URL.Path})
+			if err != nil {
+				entry.WithError(err).Warn("WebDAV")
+				return
+			}
+			entry.Debug("WebDAV")
+		},
+	}
+
+	if *readOnly {
+		handler = webdavfs.ReadOnly(handler)
+	} else if httpTLSConfig.CAFile == "" {
+		log.Warnf("Anyone reaching %s may write to export %q, use -http-tls-ca or -read-only", *listen, *export)
+	}
+	srv := &http.Server{Addr: *listen, Handler: handler}
+	if !httpTLSConfig.Active() {
+		log.Infof("Serve WebDAV on %s", *listen)
+		err = srv.ListenAndServe()
+	} else {
+		if srv.TLSConfig, err = httpTLSConfig.ServerTLSConfig(); err != nil {
+			log.Fatal(err)
+		}
+		log.Infof("Serve WebDAV over TLS on %s", *listen)
+		err = srv.ListenAndServeTLS("", "")
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -29,6 +29,11 @@ import (
 )
 
 func (s *server) Access(ctx context.Context, req *pb.AccessRequest) (*pb.AccessResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -37,7 +42,7 @@ func (s *server) Access(ctx context.Context, req *pb.AccessRequest) (*pb.AccessR
 	}).Debug("Access")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Access(ctx.Done(), &fuse.AccessIn{InHeader: header, Mask: req.Mask, Padding: req.Padding})
+	st := e.fs.Access(ctx.Done(), &fuse.AccessIn{InHeader: header, Mask: req.Mask, Padding: req.Padding})
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Access not implemented")
 	}
@@ -29,6 +29,11 @@ import (
 )
 
 func (s *server) GetAttr(ctx context.Context, req *pb.GetAttrRequest) (*pb.GetAttrResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		out    fuse.AttrOut
 		header fuse.InHeader
@@ -38,7 +43,7 @@ func (s *server) GetAttr(ctx context.Context, req *pb.GetAttrRequest) (*pb.GetAt
 	}).Debug("GetAttr")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.GetAttr(ctx.Done(), &fuse.GetAttrIn{InHeader: header}, &out)
+	st := e.fs.GetAttr(ctx.Done(), &fuse.GetAttrIn{InHeader: header}, &out)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method GetAttr not implemented")
 	}
@@ -56,6 +61,11 @@ func (s *server) GetAttr(ctx context.Context, req *pb.GetAttrRequest) (*pb.GetAt
 }
 
 func (s *server) SetAttr(ctx context.Context, req *pb.SetAttrRequest) (*pb.SetAttrResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		out    fuse.AttrOut
 		header fuse.InHeader
@@ -65,7 +75,7 @@ func (s *server) SetAttr(ctx context.Context, req *pb.SetAttrRequest) (*pb.SetAt
 	}).Debug("SetAttr")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.SetAttr(ctx.Done(),
+	st := e.fs.SetAttr(ctx.Done(),
 		&fuse.SetAttrIn{
 			SetAttrInCommon: fuse.SetAttrInCommon{
 				InHeader:  header,
@@ -97,6 +107,11 @@ func (s *server) SetAttr(ctx context.Context, req *pb.SetAttrRequest) (*pb.SetAt
 	if st != fuse.OK {
 		return &pb.SetAttrResponse{Status: &pb.Status{Code: int32(st)}}, nil
 	}
+	if req.Valid&fuse.FATTR_SIZE != 0 {
+		e.notifier.data(ctx, req.Header.NodeId, 0, 0)
+	} else {
+		e.notifier.attr(ctx, req.Header.NodeId)
+	}
 	return &pb.SetAttrResponse{
 		AttrOut: &pb.AttrOut{
 			Attr: toPbAttr(&out.Attr),
@@ -0,0 +1,42 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fuse2grpc
+
+import (
+	"context"
+
+	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/checksum"
+)
+
+// verifyWrite reports whether the data of req matches its checksum.
+func verifyWrite(ctx context.Context, method string, req *pb.WriteRequest) bool {
+	if checksum.Verify(req.ChecksumType, req.Data, req.Checksum, method) {
+		return true
+	}
+	grpc_logrus.Extract(ctx).WithFields(log.Fields{
+		"nodeId":   req.Header.GetNodeId(),
+		"fh":       req.Fh,
+		"offset":   req.Offset,
+		"bytes":    len(req.Data),
+		"checksum": req.ChecksumType,
+	}).Error("Checksum mismatch, data corrupted in transit")
+	return false
+}
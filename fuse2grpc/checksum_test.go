@@ -0,0 +1,67 @@
+package fuse2grpc_test
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/checksum"
+)
+
+func TestChecksum(t *testing.T) {
+	server, fs := startTestServices(t, 0)
+	defer server.Stop()
+
+	client, conn := newRawFileSystemClient(t, serverSocketPath)
+	defer conn.Close()
+
+	ctx, cancel := Context()
+	defer cancel()
+
+	const typ = pb.ChecksumType_CHECKSUM_XXHASH
+	data := []byte("hello world")
+
+	fs.EXPECT().Read(gomock.Any(), gomock.Any(), gomock.Any()).Return(fuse.ReadResultData(data), fuse.OK)
+	stream, err := client.Read(ctx, &pb.ReadRequest{
+		ReadIn:       &pb.ReadIn{Header: TestInHeader, Fh: 1, Size: uint32(len(data))},
+		ChecksumType: typ,
+	})
+	require.NoError(t, err)
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, checksum.Sum(typ, data), res.Checksum)
+
+	fs.EXPECT().Write(gomock.Any(), gomock.Any(), data).Return(uint32(len(data)), fuse.OK)
+	wres, err := client.Write(ctx, &pb.WriteRequest{
+		Header: TestInHeader, Fh: 1, Size: uint32(len(data)), Data: data,
+		ChecksumType: typ, Checksum: checksum.Sum(typ, data),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), wres.Status.Code)
+
+	// corrupted data does not reach the file system
+	wres, err = client.Write(ctx, &pb.WriteRequest{
+		Header: TestInHeader, Fh: 1, Size: uint32(len(data)), Data: []byte("hello worle"),
+		ChecksumType: typ, Checksum: checksum.Sum(typ, data),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(syscall.EIO), wres.Status.Code)
+
+	ws, err := client.WriteStream(ctx)
+	require.NoError(t, err)
+	require.NoError(t, ws.Send(&pb.WriteRequest{
+		Header: TestInHeader, Fh: 1, Size: 11, Data: []byte("hello"),
+		ChecksumType: typ, Checksum: checksum.Sum(typ, []byte("hello")),
+	}))
+	require.NoError(t, ws.Send(&pb.WriteRequest{
+		Data:         []byte(" worle"),
+		ChecksumType: typ, Checksum: checksum.Sum(typ, []byte(" world")),
+	}))
+	wres, err = ws.CloseAndRecv()
+	require.NoError(t, err)
+	require.Equal(t, int32(syscall.EIO), wres.Status.Code)
+}
@@ -29,6 +29,11 @@ import (
 )
 
 func (s *server) CopyFileRange(ctx context.Context, req *pb.CopyFileRangeRequest) (*pb.CopyFileRangeResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -44,9 +49,12 @@ func (s *server) CopyFileRange(ctx context.Context, req *pb.CopyFileRangeRequest
 	}).Debug("CopyFileRange")
 	toFuseInHeader(req.Header, &header)
 
-	writen, st := s.fs.CopyFileRange(ctx.Done(), &fuse.CopyFileRangeIn{InHeader: header, FhIn: req.FhIn, OffIn: req.OffIn, NodeIdOut: req.NodeIdOut, FhOut: req.FhOut, OffOut: req.OffOut, Len: req.Len, Flags: req.Flags})
+	writen, st := e.fs.CopyFileRange(ctx.Done(), &fuse.CopyFileRangeIn{InHeader: header, FhIn: req.FhIn, OffIn: req.OffIn, NodeIdOut: req.NodeIdOut, FhOut: req.FhOut, OffOut: req.OffOut, Len: req.Len, Flags: req.Flags})
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method CopyFileRange not implemented")
 	}
+	if st == fuse.OK {
+		e.notifier.data(ctx, req.NodeIdOut, int64(req.OffOut), int64(writen))
+	}
 	return &pb.CopyFileRangeResponse{Written: writen, Status: &pb.Status{Code: int32(st)}}, nil
 }
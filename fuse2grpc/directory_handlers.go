@@ -32,6 +32,11 @@ import (
 )
 
 func (s *server) OpenDir(ctx context.Context, req *pb.OpenDirRequest) (*pb.OpenDirResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 		out    fuse.OpenOut
@@ -43,13 +48,14 @@ func (s *server) OpenDir(ctx context.Context, req *pb.OpenDirRequest) (*pb.OpenD
 	}).Debug("OpenDir")
 	toFuseInHeader(req.OpenIn.Header, &header)
 
-	st := s.fs.OpenDir(ctx.Done(), &fuse.OpenIn{InHeader: header, Flags: req.OpenIn.Flags, Mode: req.OpenIn.Mode}, &out)
+	st := e.fs.OpenDir(ctx.Done(), &fuse.OpenIn{InHeader: header, Flags: req.OpenIn.Flags, Mode: req.OpenIn.Mode}, &out)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method OpenDir not implemented")
 	}
 	if st != fuse.OK {
 		return &pb.OpenDirResponse{Status: &pb.Status{Code: int32(st)}}, nil
 	}
+	e.sessions.openDir(ctx, header.NodeId, out.Fh)
 	return &pb.OpenDirResponse{
 		OpenOut: &pb.OpenOut{
 			Fh:        out.Fh,
@@ -63,6 +69,7 @@ func (s *server) OpenDir(ctx context.Context, req *pb.OpenDirRequest) (*pb.OpenD
 func (s *server) doReadDir(
 	req *pb.ReadDirRequest,
 	stream pb.RawFileSystem_ReadDirServer,
+	sessions *sessionTracker,
 	reader func(cancel <-chan struct{}, input *fuse.ReadIn, out *fuse.DirEntryList) fuse.Status,
 	readerName string,
 	prefix uint32,
@@ -133,8 +140,18 @@ func (s *server) doReadDir(
 		if e.Off == 0 {
 			break
 		}
+		dirEntry := &pb.DirEntry{Mode: typeToMode(e.Typ), Ino: e.Ino, Name: buf[pos+direntSize : pos+direntSize+e.NameLen]}
 		// uint64 Ino uint64 Offset uint32 NameLen uint32 Typ
 		delta = deltaSize(e)
+		if prefix > 0 {
+			// ReadDirPlus looks up the entries, except . and ..
+			entry := (*fuse.EntryOut)(unsafe.Pointer(&buf[pos-prefix]))
+			if entry.NodeId != 0 {
+				sessions.lookup(ctx, entry.NodeId)
+				dirEntry.EntryOut = toPbEntryOut(entry)
+				delta += int(prefix)
+			}
+		}
 		if batchSize+delta > s.msgSizeThreshold {
 			if err := flushFunc(); err != nil {
 				return err
@@ -142,7 +159,6 @@ func (s *server) doReadDir(
 			batch = nil
 			batchSize = 0
 		}
-		dirEntry := &pb.DirEntry{Mode: typeToMode(e.Typ), Ino: e.Ino, Name: buf[pos+direntSize : pos+direntSize+e.NameLen]}
 		batch = append(batch, dirEntry)
 		batchSize += delta
 		// fuse.DirEntryList.Add()
@@ -157,7 +173,11 @@ func (s *server) doReadDir(
 }
 
 func (s *server) ReadDir(req *pb.ReadDirRequest, stream pb.RawFileSystem_ReadDirServer) error {
-	return s.doReadDir(req, stream, s.fs.ReadDir, "ReadDir", 0)
+	e, err := s.getExport(stream.Context())
+	if err != nil {
+		return err
+	}
+	return s.doReadDir(req, stream, e.sessions, e.fs.ReadDir, "ReadDir", 0)
 }
 
 func deltaSize(e *_Dirent) int {
@@ -166,10 +186,19 @@ func deltaSize(e *_Dirent) int {
 }
 
 func (s *server) ReadDirPlus(req *pb.ReadDirRequest, stream pb.RawFileSystem_ReadDirPlusServer) error {
-	return s.doReadDir(req, stream, s.fs.ReadDirPlus, "ReadDirPlus", entryOutSize)
+	e, err := s.getExport(stream.Context())
+	if err != nil {
+		return err
+	}
+	return s.doReadDir(req, stream, e.sessions, e.fs.ReadDirPlus, "ReadDirPlus", entryOutSize)
 }
 
 func (s *server) ReleaseDir(ctx context.Context, req *pb.ReleaseRequest) (*emptypb.Empty, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -181,11 +210,17 @@ func (s *server) ReleaseDir(ctx context.Context, req *pb.ReleaseRequest) (*empty
 		"lockOwner":    req.LockOwner,
 	}).Debug("ReleaseDir")
 	toFuseInHeader(req.Header, &header)
-	s.fs.ReleaseDir(&fuse.ReleaseIn{InHeader: header, Fh: req.Fh, Flags: req.Flags, ReleaseFlags: req.ReleaseFlags, LockOwner: req.LockOwner})
+	e.sessions.releaseDir(ctx, req.Fh)
+	e.fs.ReleaseDir(&fuse.ReleaseIn{InHeader: header, Fh: req.Fh, Flags: req.Flags, ReleaseFlags: req.ReleaseFlags, LockOwner: req.LockOwner})
 	return &emptypb.Empty{}, nil
 }
 
 func (s *server) FsyncDir(ctx context.Context, req *pb.FsyncRequest) (*pb.FsyncResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -197,7 +232,7 @@ func (s *server) FsyncDir(ctx context.Context, req *pb.FsyncRequest) (*pb.FsyncR
 	}).Debug("FsyncDir")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.FsyncDir(ctx.Done(), &fuse.FsyncIn{InHeader: header, Fh: req.Fh, FsyncFlags: req.FsyncFlags, Padding: req.Padding})
+	st := e.fs.FsyncDir(ctx.Done(), &fuse.FsyncIn{InHeader: header, Fh: req.Fh, FsyncFlags: req.FsyncFlags, Padding: req.Padding})
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method FsyncDir not implemented")
 	}
@@ -51,3 +51,51 @@ func TestReadDdir(t *testing.T) {
 		{Name: []byte("foo4"), Mode: 0100000, Ino: 4},
 	}, res.Entries)
 }
+
+func TestReadDirPlus(t *testing.T) {
+	server, fs := startTestServices(t, 0)
+	defer server.Stop()
+
+	client, conn := newRawFileSystemClient(t, serverSocketPath)
+	defer conn.Close()
+
+	ctx, cancel := Context()
+	defer cancel()
+
+	req := &pb.ReadDirRequest{
+		ReadIn: &pb.ReadIn{
+			Header: TestInHeader,
+			Fh:     1,
+			Offset: 0,
+			Size:   8192,
+		},
+	}
+
+	fs.EXPECT().ReadDirPlus(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(cancel <-chan struct{}, input *fuse.ReadIn, out *fuse.DirEntryList) fuse.Status {
+			// . is not looked up
+			out.AddDirLookupEntry(fuse.DirEntry{Name: ".", Mode: fuse.S_IFDIR, Ino: 1})
+			entry := out.AddDirLookupEntry(fuse.DirEntry{Name: "foo", Mode: fuse.S_IFREG, Ino: 2})
+			entry.NodeId = 2
+			entry.EntryValid = 60
+			entry.Attr.Ino = 2
+			entry.Attr.Size = 5
+			return fuse.OK
+		})
+	fs.EXPECT().Forget(uint64(2), uint64(1)).AnyTimes()
+
+	stream, err := client.ReadDirPlus(ctx, req)
+	require.NoError(t, err)
+
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+
+	require.Equal(t, ".", string(res.Entries[0].Name))
+	require.Nil(t, res.Entries[0].EntryOut)
+
+	require.Equal(t, "foo", string(res.Entries[1].Name))
+	require.Equal(t, uint64(2), res.Entries[1].EntryOut.NodeId)
+	require.Equal(t, uint64(60), res.Entries[1].EntryOut.EntryValid)
+	require.Equal(t, uint64(5), res.Entries[1].EntryOut.Attr.Size)
+}
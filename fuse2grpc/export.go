@@ -0,0 +1,103 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fuse2grpc
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+// DefaultExport is the name of the export used by clients which do not
+// select one.
+const DefaultExport = ""
+
+// export is one of the file systems served.
+type export struct {
+	name     string
+	fs       fuse.RawFileSystem
+	sessions *sessionTracker
+	notifier *notifier
+
+	// initOnce guards the Init of fs.
+	initOnce sync.Once
+}
+
+// AddExport serves fs to the clients selecting name with the
+// utils.ExportKey metadata. An existing export of the same name is
+// replaced.
+func (s *server) AddExport(name string, fs fuse.RawFileSystem) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessions := newSessionTracker(fs)
+	sessions.setGrace(s.sessionGrace)
+
+	n := newNotifier()
+	for _, e := range s.exports {
+		if sameFS(e.fs, fs) {
+			n = e.notifier
+			break
+		}
+	}
+	s.exports[name] = &export{name: name, fs: fs, sessions: sessions, notifier: n}
+}
+
+// getExport returns the export selected by the metadata of ctx.
+func (s *server) getExport(ctx context.Context) (*export, error) {
+	name := utils.FromIncomingContext(ctx, utils.ExportKey)
+
+	s.mu.RLock()
+	e, ok := s.exports[name]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "export %q not found", name)
+	}
+	return e, nil
+}
+
+func (s *server) allExports() []*export {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	exports := make([]*export, 0, len(s.exports))
+	for _, e := range s.exports {
+		exports = append(exports, e)
+	}
+	return exports
+}
+
+func (s *server) ListExports(ctx context.Context, req *pb.ListExportsRequest) (*pb.ListExportsResponse, error) {
+	grpc_logrus.Extract(ctx).Debug("ListExports")
+
+	res := &pb.ListExportsResponse{}
+	for _, e := range s.allExports() {
+		if e.name != DefaultExport {
+			res.Exports = append(res.Exports, e.name)
+		}
+	}
+	sort.Strings(res.Exports)
+	return res, nil
+}
@@ -0,0 +1,60 @@
+package fuse2grpc_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/fuse2grpc"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+func TestExports(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defaultFS := mock.NewMockRawFileSystem(ctl)
+	homeFS := mock.NewMockRawFileSystem(ctl)
+	dataFS := mock.NewMockRawFileSystem(ctl)
+
+	s := fuse2grpc.NewServer(defaultFS)
+	s.AddExport("home", homeFS)
+	s.AddExport("data", dataFS)
+
+	server := serveTestServer(t, s)
+	defer server.Stop()
+
+	client, conn := newRawFileSystemClient(t, serverSocketPath)
+	defer conn.Close()
+
+	ctx, cancel := Context()
+	defer cancel()
+
+	res, err := client.ListExports(ctx, &pb.ListExportsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"data", "home"}, res.Exports)
+
+	defaultFS.EXPECT().String().Return("default")
+	homeFS.EXPECT().String().Return("home")
+	dataFS.EXPECT().String().Return("data")
+
+	for _, name := range []string{"", "home", "data"} {
+		ctx := ctx
+		if name != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, utils.ExportKey, name)
+		}
+		res, err := client.String(ctx, &pb.StringRequest{})
+		require.NoError(t, err)
+		if name == "" {
+			name = "default"
+		}
+		require.Equal(t, name, res.Value)
+	}
+
+	_, err = client.String(metadata.AppendToOutgoingContext(ctx, utils.ExportKey, "unknown"), &pb.StringRequest{})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
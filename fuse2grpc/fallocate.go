@@ -29,6 +29,11 @@ import (
 )
 
 func (s *server) Fallocate(ctx context.Context, req *pb.FallocateRequest) (*pb.FallocateResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -42,9 +47,12 @@ func (s *server) Fallocate(ctx context.Context, req *pb.FallocateRequest) (*pb.F
 	}).Debug("Fallocate")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Fallocate(ctx.Done(), &fuse.FallocateIn{InHeader: header, Fh: req.Fh, Offset: req.Offset, Length: req.Length, Mode: req.Mode, Padding: req.Padding})
+	st := e.fs.Fallocate(ctx.Done(), &fuse.FallocateIn{InHeader: header, Fh: req.Fh, Offset: req.Offset, Length: req.Length, Mode: req.Mode, Padding: req.Padding})
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Fallocate not implemented")
 	}
+	if st == fuse.OK {
+		e.notifier.data(ctx, req.Header.NodeId, int64(req.Offset), int64(req.Length))
+	}
 	return &pb.FallocateResponse{Status: &pb.Status{Code: int32(st)}}, nil
 }
@@ -18,6 +18,7 @@ package fuse2grpc
 
 import (
 	"context"
+	"syscall"
 
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
 	"github.com/hanwen/go-fuse/v2/fuse"
@@ -26,9 +27,15 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/checksum"
 )
 
 func (s *server) Create(ctx context.Context, req *pb.CreateRequest) (*pb.CreateResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 		out    fuse.CreateOut
@@ -41,13 +48,16 @@ func (s *server) Create(ctx context.Context, req *pb.CreateRequest) (*pb.CreateR
 	}).Debug("Create")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Create(ctx.Done(), &fuse.CreateIn{InHeader: header, Flags: req.Flags, Mode: req.Mode}, req.Name, &out)
+	st := e.fs.Create(ctx.Done(), &fuse.CreateIn{InHeader: header, Flags: req.Flags, Mode: req.Mode}, req.Name, &out)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 	}
 	if st != fuse.OK {
 		return &pb.CreateResponse{Status: &pb.Status{Code: int32(st)}}, nil
 	}
+	e.sessions.lookup(ctx, out.NodeId)
+	e.sessions.open(ctx, out.NodeId, out.Fh)
+	e.notifier.entry(ctx, req.Header.NodeId, req.Name)
 	return &pb.CreateResponse{
 		EntryOut: toPbEntryOut(&out.EntryOut),
 		OpenOut: &pb.OpenOut{
@@ -60,6 +70,11 @@ func (s *server) Create(ctx context.Context, req *pb.CreateRequest) (*pb.CreateR
 }
 
 func (s *server) Open(ctx context.Context, req *pb.OpenRequest) (*pb.OpenResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 		out    fuse.OpenOut
@@ -71,13 +86,17 @@ func (s *server) Open(ctx context.Context, req *pb.OpenRequest) (*pb.OpenRespons
 	}).Debug("Open")
 	toFuseInHeader(req.OpenIn.Header, &header)
 
-	st := s.fs.Open(ctx.Done(), &fuse.OpenIn{InHeader: header, Flags: req.OpenIn.Flags, Mode: req.OpenIn.Mode}, &out)
+	st := e.fs.Open(ctx.Done(), &fuse.OpenIn{InHeader: header, Flags: req.OpenIn.Flags, Mode: req.OpenIn.Mode}, &out)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Open not implemented")
 	}
 	if st != fuse.OK {
 		return &pb.OpenResponse{Status: &pb.Status{Code: int32(st)}}, nil
 	}
+	e.sessions.open(ctx, header.NodeId, out.Fh)
+	if req.OpenIn.Flags&syscall.O_TRUNC != 0 {
+		e.notifier.data(ctx, header.NodeId, 0, 0)
+	}
 	return &pb.OpenResponse{
 		OpenOut: &pb.OpenOut{
 			Fh:        out.Fh,
@@ -95,6 +114,10 @@ func (s *server) Read(req *pb.ReadRequest, stream pb.RawFileSystem_ReadServer) e
 		batch  []byte
 	)
 	ctx := stream.Context()
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return err
+	}
 
 	grpc_logrus.Extract(ctx).WithFields(log.Fields{
 		"nodeId":    req.ReadIn.Header.NodeId,
@@ -108,7 +131,7 @@ func (s *server) Read(req *pb.ReadRequest, stream pb.RawFileSystem_ReadServer) e
 	buf := s.buffers.AllocBuffer(req.ReadIn.Size)
 	defer s.buffers.FreeBuffer(buf)
 
-	res, st := s.fs.Read(ctx.Done(),
+	res, st := e.fs.Read(ctx.Done(),
 		&fuse.ReadIn{
 			InHeader:  header,
 			Fh:        req.ReadIn.Fh,
@@ -138,8 +161,9 @@ func (s *server) Read(req *pb.ReadRequest, stream pb.RawFileSystem_ReadServer) e
 			return nil
 		}
 		if err := stream.Send(&pb.ReadResponse{
-			Buffer: batch,
-			Status: &pb.Status{Code: 0},
+			Buffer:   batch,
+			Status:   &pb.Status{Code: 0},
+			Checksum: checksum.Sum(req.ChecksumType, batch),
 		}); err != nil {
 			return err
 		}
@@ -160,6 +184,11 @@ func (s *server) Read(req *pb.ReadRequest, stream pb.RawFileSystem_ReadServer) e
 	return nil
 }
 func (s *server) Lseek(ctx context.Context, req *pb.LseekRequest) (*pb.LseekResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 		out    fuse.LseekOut
@@ -173,7 +202,7 @@ func (s *server) Lseek(ctx context.Context, req *pb.LseekRequest) (*pb.LseekResp
 	}).Debug("Lseek")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Lseek(ctx.Done(), &fuse.LseekIn{InHeader: header, Fh: req.Fh, Offset: req.Offset, Whence: req.Whence, Padding: req.Padding}, &out)
+	st := e.fs.Lseek(ctx.Done(), &fuse.LseekIn{InHeader: header, Fh: req.Fh, Offset: req.Offset, Whence: req.Whence, Padding: req.Padding}, &out)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Lseek not implemented")
 	}
@@ -1,13 +1,16 @@
 package fuse2grpc_test
 
 import (
+	"bytes"
 	"io"
 	"testing"
 
+	"github.com/chiyutianyi/grpcfuse/compress"
 	"github.com/chiyutianyi/grpcfuse/pb"
 	"github.com/golang/mock/gomock"
 	"github.com/hanwen/go-fuse/v2/fuse"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 )
 
 func TestRead(t *testing.T) {
@@ -61,3 +64,35 @@ func TestRead(t *testing.T) {
 		idx++
 	}
 }
+
+func TestReadCompressed(t *testing.T) {
+	server, fs := startTestServices(t, 0)
+	defer server.Stop()
+
+	client, conn := newRawFileSystemClient(t, serverSocketPath)
+	defer conn.Close()
+
+	ctx, cancel := Context()
+	defer cancel()
+
+	data := bytes.Repeat([]byte("hello world\n"), 1000)
+	req := &pb.ReadRequest{ReadIn: &pb.ReadIn{Header: TestInHeader, Fh: 1, Size: uint32(len(data))}}
+
+	for _, name := range compress.Names {
+		fs.EXPECT().Read(gomock.Any(), gomock.Any(), gomock.Any()).Return(fuse.ReadResultData(data), fuse.OK)
+
+		stream, err := client.Read(ctx, req, grpc.UseCompressor(name))
+		require.NoError(t, err, name)
+
+		var got []byte
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err, name)
+			got = append(got, resp.Buffer...)
+		}
+		require.Equal(t, data, got, name)
+	}
+}
@@ -27,10 +27,32 @@ import (
 )
 
 func (s *server) Forget(ctx context.Context, req *pb.ForgetRequest) (*emptypb.Empty, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	grpc_logrus.Extract(ctx).WithFields(log.Fields{
 		"nodeid":  req.Nodeid,
 		"nlookup": req.Nlookup,
 	}).Debug("Forget")
-	s.fs.Forget(req.Nodeid, req.Nlookup)
+	e.sessions.forget(ctx, req.Nodeid, req.Nlookup)
+	e.fs.Forget(req.Nodeid, req.Nlookup)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) BatchForget(ctx context.Context, req *pb.BatchForgetRequest) (*emptypb.Empty, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpc_logrus.Extract(ctx).WithFields(log.Fields{
+		"count": len(req.Forgets),
+	}).Debug("BatchForget")
+	for _, forget := range req.Forgets {
+		e.sessions.forget(ctx, forget.Nodeid, forget.Nlookup)
+		e.fs.Forget(forget.Nodeid, forget.Nlookup)
+	}
 	return &emptypb.Empty{}, nil
 }
@@ -29,6 +29,11 @@ import (
 )
 
 func (s *server) Fsync(ctx context.Context, req *pb.FsyncRequest) (*pb.FsyncResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -40,7 +45,7 @@ func (s *server) Fsync(ctx context.Context, req *pb.FsyncRequest) (*pb.FsyncResp
 	}).Debug("Fsync")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Fsync(ctx.Done(), &fuse.FsyncIn{InHeader: header, Fh: req.Fh, FsyncFlags: req.FsyncFlags, Padding: req.Padding})
+	st := e.fs.Fsync(ctx.Done(), &fuse.FsyncIn{InHeader: header, Fh: req.Fh, FsyncFlags: req.FsyncFlags, Padding: req.Padding})
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Fsync not implemented")
 	}
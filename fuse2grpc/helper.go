@@ -45,6 +45,32 @@ func toPbEntryOut(in *fuse.EntryOut) *pb.EntryOut {
 	}
 }
 
+func toFuseInitIn(in *pb.KernelSettings, out *fuse.InitIn) {
+	out.Major = in.Major
+	out.Minor = in.Minor
+	out.MaxReadAhead = in.MaxReadahead
+	out.Flags = in.Flags
+}
+
+func toFuseMountOptions(in *pb.MountOptions, out *fuse.MountOptions) {
+	out.AllowOther = in.AllowOther
+	out.Options = in.Options
+	out.MaxBackground = int(in.MaxBackground)
+	out.MaxWrite = int(in.MaxWrite)
+	out.MaxReadAhead = int(in.MaxReadAhead)
+	out.IgnoreSecurityLabels = in.IgnoreSecurityLabels
+	out.RememberInodes = in.RememberInodes
+	out.FsName = in.FsName
+	out.Name = in.Name
+	out.SingleThreaded = in.SingleThreaded
+	out.DisableXAttrs = in.DisableXattrs
+	out.EnableLocks = in.EnableLocks
+	out.ExplicitDataCacheControl = in.ExplicitDataCacheControl
+	out.SyncRead = in.SyncRead
+	out.DirectMount = in.DirectMount
+	out.EnableAcl = in.EnableAcl
+}
+
 // modeToType converts a file *type* (as used in _Dirent.Typ)
 // to a file *mode* (as used in syscall.Stat_t.Mode).
 func typeToMode(typ uint32) uint32 {
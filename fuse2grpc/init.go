@@ -0,0 +1,73 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fuse2grpc
+
+import (
+	"context"
+
+	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/compress"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+// DisableFeatures stops advertising features in the responses to Init,
+// e.g. FEATURE_LOCKS for file systems without locking. Clients honoring
+// Init then avoid the corresponding requests.
+func (s *server) DisableFeatures(features ...pb.Feature) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, f := range features {
+		s.features &^= uint64(f)
+	}
+}
+
+func (s *server) Init(ctx context.Context, req *pb.InitRequest) (*pb.InitResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clientID := utils.FromIncomingContext(ctx, utils.ClientIDKey)
+	grpc_logrus.Extract(ctx).WithFields(log.Fields{
+		"clientId": clientID,
+	}).Debugf("Init %d.%d features %#x", req.Major, req.Minor, req.Features)
+	if req.Major != utils.ProtocolMajor {
+		return nil, status.Errorf(codes.FailedPrecondition, "client protocol %d.%d is incompatible with server protocol %d.%d",
+			req.Major, req.Minor, utils.ProtocolMajor, utils.ProtocolMinor)
+	}
+	if req.Kernel != nil {
+		e.initKernel(clientID, req)
+	}
+
+	s.mu.RLock()
+	features := s.features
+	s.mu.RUnlock()
+	res := &pb.InitResponse{
+		Major:    utils.ProtocolMajor,
+		Minor:    utils.ProtocolMinor,
+		Features: features,
+	}
+	if utils.HasFeature(features, pb.Feature_FEATURE_COMPRESSION) {
+		res.Compressors = compress.Names
+	}
+	return res, nil
+}
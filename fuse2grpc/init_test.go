@@ -0,0 +1,94 @@
+package fuse2grpc_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/compress"
+	"github.com/chiyutianyi/grpcfuse/fuse2grpc"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+func TestInit(t *testing.T) {
+	ctl := gomock.NewController(t)
+	s := fuse2grpc.NewServer(mock.NewMockRawFileSystem(ctl))
+	s.DisableFeatures(pb.Feature_FEATURE_LOCKS)
+
+	server := serveTestServer(t, s)
+	defer server.Stop()
+
+	client, conn := newRawFileSystemClient(t, serverSocketPath)
+	defer conn.Close()
+
+	ctx, cancel := Context()
+	defer cancel()
+
+	res, err := client.Init(ctx, &pb.InitRequest{Major: utils.ProtocolMajor, Features: utils.Features})
+	require.NoError(t, err)
+	require.Equal(t, uint32(utils.ProtocolMajor), res.Major)
+	require.True(t, utils.HasFeature(res.Features, pb.Feature_FEATURE_WRITE_STREAM))
+	require.False(t, utils.HasFeature(res.Features, pb.Feature_FEATURE_LOCKS))
+	require.Equal(t, compress.Names, res.Compressors)
+
+	_, err = client.Init(ctx, &pb.InitRequest{Major: utils.ProtocolMajor + 1})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+type kernelFS struct {
+	*mock.MockRawFileSystem
+
+	kernels chan *fuse2grpc.Kernel
+}
+
+func (fs *kernelFS) InitKernel(k *fuse2grpc.Kernel) {
+	fs.kernels <- k
+}
+
+func TestInitKernel(t *testing.T) {
+	ctl := gomock.NewController(t)
+	plainFS := mock.NewMockRawFileSystem(ctl)
+	kfs := &kernelFS{MockRawFileSystem: mock.NewMockRawFileSystem(ctl), kernels: make(chan *fuse2grpc.Kernel, 1)}
+
+	s := fuse2grpc.NewServer(plainFS)
+	s.AddExport("kernel", kfs)
+
+	server := serveTestServer(t, s)
+	defer server.Stop()
+
+	client, conn := newRawFileSystemClient(t, serverSocketPath)
+	defer conn.Close()
+
+	ctx, cancel := Context()
+	defer cancel()
+
+	req := &pb.InitRequest{
+		Major:        utils.ProtocolMajor,
+		Kernel:       &pb.KernelSettings{Major: 7, Minor: 31, Flags: fuse.CAP_READDIRPLUS},
+		MountOptions: &pb.MountOptions{MaxWrite: 1 << 17, EnableLocks: true},
+	}
+
+	// file systems without InitKernel are initialized once
+	plainFS.EXPECT().String().Return("plain").AnyTimes()
+	plainFS.EXPECT().Init(gomock.Any())
+	for i := 0; i < 2; i++ {
+		_, err := client.Init(ctx, req)
+		require.NoError(t, err)
+	}
+
+	_, err := client.Init(metadata.AppendToOutgoingContext(ctx, utils.ClientIDKey, "client", utils.ExportKey, "kernel"), req)
+	require.NoError(t, err)
+	k := <-kfs.kernels
+	require.Equal(t, "client", k.ClientID())
+	require.Equal(t, uint32(31), k.KernelSettings().Minor)
+	require.Equal(t, uint32(fuse.CAP_READDIRPLUS), k.KernelSettings().Flags)
+	require.Equal(t, 1<<17, k.MountOptions().MaxWrite)
+	require.True(t, k.MountOptions().EnableLocks)
+}
@@ -0,0 +1,355 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fuse2grpc
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"io"
+	iofs "io/fs"
+	"os"
+	"path"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+// ioFSTimeout is how long the kernel may cache the entries and
+// attributes of an io/fs.FS.
+const ioFSTimeout = time.Second
+
+// NewIOFS returns a read-only file system serving fsys, such as an
+// embed.FS or an os.DirFS, ready for NewServer or AddExport.
+//
+// The files of fsys are looked up with fs.Stat and listed with
+// fs.ReadDir, which use fs.StatFS and fs.ReadDirFS when fsys implements
+// them. Opened files are read with io.ReaderAt or io.Seeker when they
+// implement one of them, and sequentially otherwise.
+func NewIOFS(fsys iofs.FS) fuse.RawFileSystem {
+	root := &ioNode{fsys: &ioFS{
+		fsys:  fsys,
+		owner: fuse.Owner{Uid: uint32(os.Getuid()), Gid: uint32(os.Getgid())},
+	}, name: "."}
+	timeout := ioFSTimeout
+	return fs.NewNodeFS(root, &fs.Options{
+		EntryTimeout:    &timeout,
+		AttrTimeout:     &timeout,
+		NullPermissions: true,
+	})
+}
+
+// ioFS is the state shared by the nodes of an io/fs.FS.
+type ioFS struct {
+	fsys  iofs.FS
+	owner fuse.Owner
+}
+
+// pathIno returns the inode number of the file at name. It is derived
+// from the path, so a file gets the same number whenever it is looked
+// up or listed, without keeping state for every path seen. Two paths
+// get the same number with a chance of 2^-64.
+func pathIno(name string) uint64 {
+	if name == "." {
+		return fuse.FUSE_ROOT_ID
+	}
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	ino := h.Sum64()
+	if ino <= fuse.FUSE_ROOT_ID {
+		// 0 is no inode, 1 the root
+		ino += fuse.FUSE_ROOT_ID + 1
+	}
+	return ino
+}
+
+// ioNode is a file of an io/fs.FS, named by its path in fsys. Its inode
+// is dropped once forgotten, the bridge counts the lookups.
+type ioNode struct {
+	fs.Inode
+
+	fsys *ioFS
+	name string
+}
+
+var (
+	_ = (fs.NodeLookuper)((*ioNode)(nil))
+	_ = (fs.NodeGetattrer)((*ioNode)(nil))
+	_ = (fs.NodeReaddirer)((*ioNode)(nil))
+	_ = (fs.NodeOpener)((*ioNode)(nil))
+	_ = (fs.NodeReader)((*ioNode)(nil))
+	_ = (fs.NodeReleaser)((*ioNode)(nil))
+	_ = (fs.NodeStatfser)((*ioNode)(nil))
+
+	// everything modifying fsys fails with EROFS
+	_ = (fs.NodeSetattrer)((*ioNode)(nil))
+	_ = (fs.NodeMkdirer)((*ioNode)(nil))
+	_ = (fs.NodeMknoder)((*ioNode)(nil))
+	_ = (fs.NodeCreater)((*ioNode)(nil))
+	_ = (fs.NodeSymlinker)((*ioNode)(nil))
+	_ = (fs.NodeLinker)((*ioNode)(nil))
+	_ = (fs.NodeUnlinker)((*ioNode)(nil))
+	_ = (fs.NodeRmdirer)((*ioNode)(nil))
+	_ = (fs.NodeRenamer)((*ioNode)(nil))
+	_ = (fs.NodeSetxattrer)((*ioNode)(nil))
+	_ = (fs.NodeRemovexattrer)((*ioNode)(nil))
+)
+
+func (n *ioNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	child := path.Join(n.name, name)
+	info, err := iofs.Stat(n.fsys.fsys, child)
+	if err != nil {
+		return nil, toErrno(err)
+	}
+	ino := pathIno(child)
+	n.fsys.fillAttr(info, ino, &out.Attr)
+	node := &ioNode{fsys: n.fsys, name: child}
+	return n.NewInode(ctx, node, fs.StableAttr{Mode: out.Attr.Mode & syscall.S_IFMT, Ino: ino}), 0
+}
+
+func (n *ioNode) Getattr(ctx context.Context, f fs.FileHandle, out *fuse.AttrOut) syscall.Errno {
+	info, err := iofs.Stat(n.fsys.fsys, n.name)
+	if err != nil {
+		return toErrno(err)
+	}
+	n.fsys.fillAttr(info, n.StableAttr().Ino, &out.Attr)
+	return 0
+}
+
+func (n *ioNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	entries, err := iofs.ReadDir(n.fsys.fsys, n.name)
+	if err != nil {
+		return nil, toErrno(err)
+	}
+	list := make([]fuse.DirEntry, 0, len(entries))
+	for _, e := range entries {
+		list = append(list, fuse.DirEntry{
+			Name: e.Name(),
+			Mode: toUnixMode(e.Type()),
+			Ino:  pathIno(path.Join(n.name, e.Name())),
+		})
+	}
+	return fs.NewListDirStream(list), 0
+}
+
+func (n *ioNode) Open(ctx context.Context, flags uint32) (fs.FileHandle, uint32, syscall.Errno) {
+	if flags&(syscall.O_WRONLY|syscall.O_RDWR|syscall.O_TRUNC) != 0 {
+		return nil, 0, syscall.EROFS
+	}
+	f, err := n.fsys.fsys.Open(n.name)
+	if err != nil {
+		return nil, 0, toErrno(err)
+	}
+	return &ioHandle{file: f}, 0, 0
+}
+
+func (n *ioNode) Read(ctx context.Context, f fs.FileHandle, dest []byte, off int64) (fuse.ReadResult, syscall.Errno) {
+	h, ok := f.(*ioHandle)
+	if !ok {
+		return nil, syscall.EBADF
+	}
+	nread, err := h.readAt(n, dest, off)
+	if err != nil && err != io.EOF {
+		return nil, toErrno(err)
+	}
+	return fuse.ReadResultData(dest[:nread]), 0
+}
+
+func (n *ioNode) Release(ctx context.Context, f fs.FileHandle) syscall.Errno {
+	h, ok := f.(*ioHandle)
+	if !ok {
+		return syscall.EBADF
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return toErrno(h.file.Close())
+}
+
+func (n *ioNode) Statfs(ctx context.Context, out *fuse.StatfsOut) syscall.Errno {
+	out.NameLen = 255
+	out.Bsize = 4096
+	return 0
+}
+
+func (n *ioNode) Setattr(ctx context.Context, f fs.FileHandle, in *fuse.SetAttrIn, out *fuse.AttrOut) syscall.Errno {
+	return syscall.EROFS
+}
+
+func (n *ioNode) Mkdir(ctx context.Context, name string, mode uint32, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	return nil, syscall.EROFS
+}
+
+func (n *ioNode) Mknod(ctx context.Context, name string, mode uint32, dev uint32, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	return nil, syscall.EROFS
+}
+
+func (n *ioNode) Create(ctx context.Context, name string, flags uint32, mode uint32, out *fuse.EntryOut) (*fs.Inode, fs.FileHandle, uint32, syscall.Errno) {
+	return nil, nil, 0, syscall.EROFS
+}
+
+func (n *ioNode) Symlink(ctx context.Context, target, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	return nil, syscall.EROFS
+}
+
+func (n *ioNode) Link(ctx context.Context, target fs.InodeEmbedder, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	return nil, syscall.EROFS
+}
+
+func (n *ioNode) Unlink(ctx context.Context, name string) syscall.Errno {
+	return syscall.EROFS
+}
+
+func (n *ioNode) Rmdir(ctx context.Context, name string) syscall.Errno {
+	return syscall.EROFS
+}
+
+func (n *ioNode) Rename(ctx context.Context, name string, newParent fs.InodeEmbedder, newName string, flags uint32) syscall.Errno {
+	return syscall.EROFS
+}
+
+func (n *ioNode) Setxattr(ctx context.Context, attr string, data []byte, flags uint32) syscall.Errno {
+	return syscall.EROFS
+}
+
+func (n *ioNode) Removexattr(ctx context.Context, attr string) syscall.Errno {
+	return syscall.EROFS
+}
+
+// ioHandle is an open file of an io/fs.FS.
+type ioHandle struct {
+	mu   sync.Mutex
+	file iofs.File
+
+	// offset is where the next sequential read of file starts.
+	offset int64
+}
+
+// readAt reads at off, files without io.ReaderAt or io.Seeker are read
+// forward and opened again to read backwards.
+func (h *ioHandle) readAt(n *ioNode, dest []byte, off int64) (int, error) {
+	if r, ok := h.file.(io.ReaderAt); ok {
+		return r.ReadAt(dest, off)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if off != h.offset {
+		if s, ok := h.file.(io.Seeker); ok {
+			if _, err := s.Seek(off, io.SeekStart); err != nil {
+				return 0, err
+			}
+		} else {
+			if off < h.offset {
+				f, err := n.fsys.fsys.Open(n.name)
+				if err != nil {
+					return 0, err
+				}
+				h.file.Close()
+				h.file, h.offset = f, 0
+			}
+			skipped, err := io.CopyN(io.Discard, h.file, off-h.offset)
+			h.offset += skipped
+			if err != nil {
+				return 0, err
+			}
+		}
+		h.offset = off
+	}
+	nread, err := io.ReadFull(h.file, dest)
+	h.offset += int64(nread)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return nread, err
+}
+
+func (f *ioFS) fillAttr(info iofs.FileInfo, ino uint64, out *fuse.Attr) {
+	out.Ino = ino
+	out.Mode = toUnixMode(info.Mode())
+	out.Size = uint64(info.Size())
+	out.Nlink = 1
+	out.Owner = f.owner
+	if info.IsDir() {
+		out.Size = 4096
+		out.Nlink = 2
+	}
+	out.Blocks = (out.Size + 511) / 512
+	if mtime := info.ModTime(); !mtime.IsZero() {
+		out.SetTimes(&mtime, &mtime, &mtime)
+	}
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		// files of an os.DirFS keep their owner
+		out.Owner = fuse.Owner{Uid: st.Uid, Gid: st.Gid}
+		out.Nlink = uint32(st.Nlink)
+	}
+}
+
+// toUnixMode converts a fs.FileMode to the mode of a stat.
+func toUnixMode(mode iofs.FileMode) uint32 {
+	m := uint32(mode.Perm())
+	switch mode.Type() {
+	case iofs.ModeDir:
+		m |= syscall.S_IFDIR
+	case iofs.ModeSymlink:
+		m |= syscall.S_IFLNK
+	case iofs.ModeNamedPipe:
+		m |= syscall.S_IFIFO
+	case iofs.ModeSocket:
+		m |= syscall.S_IFSOCK
+	case iofs.ModeDevice | iofs.ModeCharDevice:
+		m |= syscall.S_IFCHR
+	case iofs.ModeDevice:
+		m |= syscall.S_IFBLK
+	default:
+		m |= syscall.S_IFREG
+	}
+	if mode&iofs.ModeSetuid != 0 {
+		m |= syscall.S_ISUID
+	}
+	if mode&iofs.ModeSetgid != 0 {
+		m |= syscall.S_ISGID
+	}
+	if mode&iofs.ModeSticky != 0 {
+		m |= syscall.S_ISVTX
+	}
+	return m
+}
+
+// toErrno converts the errors of an io/fs.FS, which may not come from
+// the operating system.
+func toErrno(err error) syscall.Errno {
+	var errno syscall.Errno
+	switch {
+	case err == nil:
+		return 0
+	case errors.As(err, &errno):
+		return errno
+	case errors.Is(err, iofs.ErrNotExist):
+		return syscall.ENOENT
+	case errors.Is(err, iofs.ErrPermission):
+		return syscall.EACCES
+	case errors.Is(err, iofs.ErrInvalid):
+		return syscall.EINVAL
+	case errors.Is(err, iofs.ErrClosed):
+		return syscall.EBADF
+	}
+	return syscall.EIO
+}
@@ -0,0 +1,105 @@
+package fuse2grpc_test
+
+import (
+	"io/fs"
+	"net"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/chiyutianyi/grpcfuse/fuse2grpc"
+	"github.com/chiyutianyi/grpcfuse/grpcfs"
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+// sequentialFS hides io.ReaderAt and io.Seeker of the files it opens.
+type sequentialFS struct {
+	fs.FS
+}
+
+func (s sequentialFS) Open(name string) (fs.File, error) {
+	f, err := s.FS.Open(name)
+	if _, ok := f.(fs.ReadDirFile); ok || err != nil {
+		return f, err
+	}
+	return struct{ fs.File }{f}, nil
+}
+
+func serveIOFS(t *testing.T, fsys fs.FS) *grpcfs.FS {
+	socket := filepath.Join(t.TempDir(), "iofs.sock")
+	l, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	srv := fuse2grpc.NewServer(fuse2grpc.NewIOFS(fsys))
+	s := grpc.NewServer(grpc.StatsHandler(srv.StatsHandler()))
+	pb.RegisterRawFileSystemServer(s, srv)
+	go s.Serve(l)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return grpcfs.New(pb.NewRawFileSystemClient(conn))
+}
+
+func TestIOFS(t *testing.T) {
+	files := fstest.MapFS{
+		"hello.txt":     {Data: []byte("hello world"), Mode: 0644},
+		"dir/a.txt":     {Data: []byte("a"), Mode: 0600},
+		"dir/sub/b.txt": {Data: []byte("b")},
+		"empty":         {Mode: fs.ModeDir | 0755},
+	}
+
+	for name, fsys := range map[string]fs.FS{"ReaderAt": files, "Sequential": sequentialFS{files}} {
+		t.Run(name, func(t *testing.T) {
+			client := serveIOFS(t, fsys)
+			require.NoError(t, fstest.TestFS(client, "hello.txt", "dir/a.txt", "dir/sub/b.txt", "empty"))
+
+			info, err := client.Stat("dir/a.txt")
+			require.NoError(t, err)
+			require.Equal(t, fs.FileMode(0600), info.Mode())
+
+			f, err := client.Open("hello.txt")
+			require.NoError(t, err)
+			defer f.Close()
+			buf := make([]byte, 5)
+			_, err = f.(*grpcfs.File).ReadAt(buf, 6)
+			require.NoError(t, err)
+			require.Equal(t, "world", string(buf))
+			_, err = f.(*grpcfs.File).ReadAt(buf, 0)
+			require.NoError(t, err)
+			require.Equal(t, "hello", string(buf))
+
+			_, err = client.Stat("missing")
+			require.ErrorIs(t, err, fs.ErrNotExist)
+			require.ErrorIs(t, client.WriteFile("new", []byte("x"), 0644), syscall.EROFS)
+			require.ErrorIs(t, client.Mkdir("dir/new", 0755), syscall.EROFS)
+			require.ErrorIs(t, client.Remove("hello.txt"), syscall.EROFS)
+		})
+	}
+}
+
+func TestIOFSInodes(t *testing.T) {
+	files := fstest.MapFS{
+		"dir/a.txt": {Data: []byte("a")},
+		"dir/b.txt": {Data: []byte("b")},
+	}
+	ino := func(client *grpcfs.FS, name string) uint64 {
+		info, err := client.Stat(name)
+		require.NoError(t, err)
+		return info.Sys().(*pb.Attr).GetIno()
+	}
+
+	// the numbers do not depend on what was looked up before
+	first, second := serveIOFS(t, files), serveIOFS(t, files)
+	a, b := ino(first, "dir/a.txt"), ino(first, "dir/b.txt")
+	require.Equal(t, b, ino(second, "dir/b.txt"))
+	require.Equal(t, a, ino(second, "dir/a.txt"))
+	require.NotEqual(t, a, b)
+	require.Greater(t, a, uint64(1))
+}
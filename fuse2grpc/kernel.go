@@ -0,0 +1,104 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fuse2grpc
+
+import (
+	"context"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+// Kernel stands in for the *fuse.Server of a file system served to a
+// client. Notifications invalidate the caches of every client of the
+// export.
+type Kernel struct {
+	clientID string
+	settings fuse.InitIn
+	opts     fuse.MountOptions
+	notifier *notifier
+}
+
+// ClientID returns the id of the client, empty if it sent none.
+func (k *Kernel) ClientID() string {
+	return k.clientID
+}
+
+// KernelSettings returns the Init message of the kernel of the client.
+// The message should not be altered.
+func (k *Kernel) KernelSettings() *fuse.InitIn {
+	return &k.settings
+}
+
+// MountOptions returns the options the client mounted with. They should
+// not be altered.
+func (k *Kernel) MountOptions() *fuse.MountOptions {
+	return &k.opts
+}
+
+// InodeNotify invalidates length bytes of node at off, all of them if
+// length is 0. A negative off only invalidates its attributes.
+func (k *Kernel) InodeNotify(node uint64, off int64, length int64) fuse.Status {
+	k.notifier.data(context.Background(), node, off, length)
+	return fuse.OK
+}
+
+// EntryNotify invalidates name in parent.
+func (k *Kernel) EntryNotify(parent uint64, name string) fuse.Status {
+	k.notifier.entry(context.Background(), parent, name)
+	return fuse.OK
+}
+
+// DeleteNotify invalidates name in parent. Clients do not learn about
+// child, whose lookups they forget as usual.
+func (k *Kernel) DeleteNotify(parent uint64, child uint64, name string) fuse.Status {
+	return k.EntryNotify(parent, name)
+}
+
+// KernelInitializer is implemented by file systems which want to know
+// how they are mounted, or to invalidate the caches of the clients.
+// InitKernel is called for every client mounting the file system, memfs
+// implements it.
+//
+// Other file systems, like the fs.NewNodeFS of a loopback, have their
+// Init called once the first client mounts them, with a fuse.Server set
+// up with the kernel settings and mount options of that client. Its
+// inode and entry notifications reach every client of the export, the
+// others fail with ENOSYS. On macOS the server is not set up, and all
+// notifications fail.
+type KernelInitializer interface {
+	InitKernel(k *Kernel)
+}
+
+// initKernel passes the kernel settings and the mount options of a
+// client to the file system of e.
+func (e *export) initKernel(clientID string, req *pb.InitRequest) {
+	k := &Kernel{clientID: clientID, notifier: e.notifier}
+	toFuseInitIn(req.Kernel, &k.settings)
+	if req.MountOptions != nil {
+		toFuseMountOptions(req.MountOptions, &k.opts)
+	}
+
+	if fs, ok := e.fs.(KernelInitializer); ok {
+		fs.InitKernel(k)
+		return
+	}
+	e.initOnce.Do(func() {
+		initServer(e.fs, k)
+	})
+}
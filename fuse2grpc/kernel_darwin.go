@@ -0,0 +1,28 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fuse2grpc
+
+import (
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+// initServer initializes fs with a fuse.Server that is not mounted,
+// macOS has no stand-in for /dev/fuse, so notifications fail with
+// ENOSYS.
+func initServer(fs fuse.RawFileSystem, k *Kernel) {
+	fs.Init(&fuse.Server{})
+}
@@ -0,0 +1,138 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fuse2grpc
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"unsafe"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	opInit = 26
+
+	// maxServerMinor caps the protocol minor of the server of initServer.
+	// Newer kernels support NOTIFY_RETRIEVE_CACHE, which waits for a
+	// reply no kernel sends, and NOTIFY_STORE_CACHE and NOTIFY_DELETE,
+	// which then fail with ENOSYS.
+	maxServerMinor = 14
+)
+
+// initServer initializes fs with a fuse.Server standing in for the
+// kernel of k. The server reads the kernel settings of k and is set up
+// with its mount options, over a socket taking the place of /dev/fuse.
+// The inode and entry notifications written to the socket are sent on
+// through k.
+func initServer(fs fuse.RawFileSystem, k *Kernel) {
+	if err := newServer(fs, k); err != nil {
+		log.Warnf("Init the file system with the kernel settings of client %q: %v", k.clientID, err)
+		fs.Init(&fuse.Server{})
+	}
+}
+
+func newServer(fs fuse.RawFileSystem, k *Kernel) error {
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_SEQPACKET|syscall.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	kernel := os.NewFile(uintptr(fds[0]), "kernel")
+
+	in := fuse.InitIn{
+		InHeader:     fuse.InHeader{Opcode: opInit, Unique: 1},
+		Major:        k.settings.Major,
+		Minor:        k.settings.Minor,
+		MaxReadAhead: k.settings.MaxReadAhead,
+		Flags:        k.settings.Flags,
+	}
+	if in.Minor > maxServerMinor {
+		in.Minor = maxServerMinor
+	}
+	in.Length = uint32(unsafe.Sizeof(in))
+	if _, err := kernel.Write((*[unsafe.Sizeof(in)]byte)(unsafe.Pointer(&in))[:]); err != nil {
+		kernel.Close()
+		syscall.Close(fds[1])
+		return err
+	}
+
+	// Only the options shaping the reply to INIT, the others are about
+	// mounting.
+	opts := &fuse.MountOptions{
+		MaxBackground:            k.opts.MaxBackground,
+		MaxWrite:                 k.opts.MaxWrite,
+		MaxReadAhead:             k.opts.MaxReadAhead,
+		EnableLocks:              k.opts.EnableLocks,
+		EnableAcl:                k.opts.EnableAcl,
+		SyncRead:                 k.opts.SyncRead,
+		ExplicitDataCacheControl: k.opts.ExplicitDataCacheControl,
+	}
+	// NewServer closes the socket if INIT fails.
+	if _, err := fuse.NewServer(fs, fmt.Sprintf("/dev/fd/%d", fds[1]), opts); err != nil {
+		kernel.Close()
+		return err
+	}
+	go readNotifications(kernel, k)
+	return nil
+}
+
+// readNotifications passes the notifications of the server on to the
+// clients, skipping the reply to INIT.
+func readNotifications(kernel *os.File, k *Kernel) {
+	defer kernel.Close()
+
+	hdrSize := int(unsafe.Sizeof(fuse.OutHeader{}))
+	buf := make([]byte, 1<<16)
+	for {
+		n, err := kernel.Read(buf)
+		if err != nil {
+			log.Errorf("Read notifications: %v", err)
+			return
+		}
+		if n < hdrSize {
+			continue
+		}
+		hdr := (*fuse.OutHeader)(unsafe.Pointer(&buf[0]))
+		if hdr.Unique != 0 {
+			// a reply
+			continue
+		}
+		body := buf[hdrSize:n]
+
+		// notifications carry their code in place of the error
+		switch -fuse.Status(hdr.Status) {
+		case fuse.NOTIFY_INVAL_INODE:
+			if len(body) < int(unsafe.Sizeof(fuse.NotifyInvalInodeOut{})) {
+				continue
+			}
+			out := (*fuse.NotifyInvalInodeOut)(unsafe.Pointer(&body[0]))
+			k.InodeNotify(out.Ino, out.Off, out.Length)
+		case fuse.NOTIFY_INVAL_ENTRY:
+			size := int(unsafe.Sizeof(fuse.NotifyInvalEntryOut{}))
+			if len(body) < size {
+				continue
+			}
+			out := (*fuse.NotifyInvalEntryOut)(unsafe.Pointer(&body[0]))
+			if len(body) < size+int(out.NameLen) {
+				continue
+			}
+			k.EntryNotify(out.Parent, string(body[size:size+int(out.NameLen)]))
+		}
+	}
+}
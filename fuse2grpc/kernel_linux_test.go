@@ -0,0 +1,62 @@
+package fuse2grpc_test
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/chiyutianyi/grpcfuse/fuse2grpc"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+func TestNodeFSNotify(t *testing.T) {
+	root := &fs.Inode{}
+	s := fuse2grpc.NewServer(fs.NewNodeFS(root, &fs.Options{}))
+
+	server := serveTestServer(t, s)
+	defer server.Stop()
+
+	client, conn := newRawFileSystemClient(t, serverSocketPath)
+	defer conn.Close()
+
+	ctx, cancel := Context()
+	defer cancel()
+
+	stream, err := client.Notify(metadata.AppendToOutgoingContext(ctx, utils.ClientIDKey, "b"), &pb.NotifyRequest{})
+	require.NoError(t, err)
+	_, err = stream.Header()
+	require.NoError(t, err)
+
+	_, err = client.Init(metadata.AppendToOutgoingContext(ctx, utils.ClientIDKey, "a"), &pb.InitRequest{
+		Major:        utils.ProtocolMajor,
+		Kernel:       &pb.KernelSettings{Major: 7, Minor: 31},
+		MountOptions: &pb.MountOptions{MaxWrite: 1 << 17},
+	})
+	require.NoError(t, err)
+
+	// notifications of the NodeFS reach the clients
+	require.Equal(t, syscall.Errno(0), root.NotifyEntry("name"))
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(fuse.FUSE_ROOT_ID), res.GetEntry().Parent)
+	require.Equal(t, "name", res.GetEntry().Name)
+	res, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(fuse.FUSE_ROOT_ID), res.GetInode().Node)
+	require.Equal(t, int64(-1), res.GetInode().Offset)
+
+	require.Equal(t, syscall.Errno(0), root.NotifyContent(10, 5))
+	res, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(fuse.FUSE_ROOT_ID), res.GetInode().Node)
+	require.Equal(t, int64(10), res.GetInode().Offset)
+	require.Equal(t, int64(5), res.GetInode().Length)
+
+	// the clients have no cache to store data in
+	require.Equal(t, syscall.ENOSYS, root.WriteCache(0, []byte("data")))
+}
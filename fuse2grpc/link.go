@@ -29,6 +29,11 @@ import (
 )
 
 func (s *server) Link(ctx context.Context, req *pb.LinkRequest) (*pb.LinkResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 		out    fuse.EntryOut
@@ -40,14 +45,24 @@ func (s *server) Link(ctx context.Context, req *pb.LinkRequest) (*pb.LinkRespons
 	}).Debug("Link")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Link(ctx.Done(), &fuse.LinkIn{InHeader: header, Oldnodeid: req.Oldnodeid}, req.Filename, &out)
+	st := e.fs.Link(ctx.Done(), &fuse.LinkIn{InHeader: header, Oldnodeid: req.Oldnodeid}, req.Filename, &out)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Link not implemented")
 	}
+	if st == fuse.OK {
+		e.sessions.lookup(ctx, out.NodeId)
+		e.notifier.entry(ctx, req.Header.NodeId, req.Filename)
+		e.notifier.attr(ctx, req.Oldnodeid)
+	}
 	return &pb.LinkResponse{EntryOut: toPbEntryOut(&out), Status: &pb.Status{Code: int32(st)}}, nil
 }
 
 func (s *server) Symlink(ctx context.Context, req *pb.SymlinkRequest) (*pb.SymlinkResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 		out    fuse.EntryOut
@@ -59,14 +74,23 @@ func (s *server) Symlink(ctx context.Context, req *pb.SymlinkRequest) (*pb.Symli
 	}).Debug("Symlink")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Symlink(ctx.Done(), &header, req.PointedTo, req.LinkName, &out)
+	st := e.fs.Symlink(ctx.Done(), &header, req.PointedTo, req.LinkName, &out)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Symlink not implemented")
 	}
+	if st == fuse.OK {
+		e.sessions.lookup(ctx, out.NodeId)
+		e.notifier.entry(ctx, req.Header.NodeId, req.LinkName)
+	}
 	return &pb.SymlinkResponse{EntryOut: toPbEntryOut(&out), Status: &pb.Status{Code: int32(st)}}, nil
 }
 
 func (s *server) Readlink(ctx context.Context, req *pb.ReadlinkRequest) (*pb.ReadlinkResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -75,7 +99,7 @@ func (s *server) Readlink(ctx context.Context, req *pb.ReadlinkRequest) (*pb.Rea
 	}).Debug("Readlink")
 	toFuseInHeader(req.Header, &header)
 
-	out, st := s.fs.Readlink(ctx.Done(), &header)
+	out, st := e.fs.Readlink(ctx.Done(), &header)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Readlink not implemented")
 	}
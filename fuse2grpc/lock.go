@@ -19,6 +19,7 @@ package fuse2grpc
 import (
 	"context"
 	"fmt"
+	"math"
 
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
 	"github.com/hanwen/go-fuse/v2/fuse"
@@ -30,6 +31,11 @@ import (
 )
 
 func (s *server) GetLk(ctx context.Context, req *pb.LkRequest) (*pb.GetLkResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 		out    fuse.LkOut
@@ -48,7 +54,7 @@ func (s *server) GetLk(ctx context.Context, req *pb.LkRequest) (*pb.GetLkRespons
 	}).Debug("GetLk")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.GetLk(ctx.Done(),
+	st := e.fs.GetLk(ctx.Done(),
 		&fuse.LkIn{
 			InHeader: header,
 			Fh:       req.Fh,
@@ -68,16 +74,25 @@ func (s *server) GetLk(ctx context.Context, req *pb.LkRequest) (*pb.GetLkRespons
 }
 
 func (s *server) SetLk(ctx context.Context, req *pb.LkRequest) (*pb.SetLkResponse, error) {
-	return s.doSetLk(ctx, req, s.fs.SetLk, "SetLk")
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.doSetLk(ctx, req, e.sessions, e.fs.SetLk, "SetLk")
 }
 
 func (s *server) SetLkw(ctx context.Context, req *pb.LkRequest) (*pb.SetLkResponse, error) {
-	return s.doSetLk(ctx, req, s.fs.SetLk, "SetLkw")
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.doSetLk(ctx, req, e.sessions, e.fs.SetLk, "SetLkw")
 }
 
 func (s *server) doSetLk(
 	ctx context.Context,
 	req *pb.LkRequest,
+	sessions *sessionTracker,
 	fn func(<-chan struct{}, *fuse.LkIn) fuse.Status,
 	funcName string) (*pb.SetLkResponse, error) {
 	var (
@@ -112,5 +127,8 @@ func (s *server) doSetLk(
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, fmt.Sprintf("method %s not implemented", funcName))
 	}
+	if st == fuse.OK {
+		sessions.setLk(ctx, req.Header.NodeId, req.Fh, req.Owner, req.Lk.Type, req.Lk.Start == 0 && req.Lk.End >= math.MaxInt64)
+	}
 	return &pb.SetLkResponse{Status: &pb.Status{Code: int32(st)}}, nil
 }
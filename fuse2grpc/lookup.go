@@ -29,6 +29,11 @@ import (
 )
 
 func (s *server) Lookup(ctx context.Context, req *pb.LookupRequest) (*pb.LookupResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		out    fuse.EntryOut
 		header fuse.InHeader
@@ -39,13 +44,14 @@ func (s *server) Lookup(ctx context.Context, req *pb.LookupRequest) (*pb.LookupR
 	}).Debug("Lookup")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Lookup(ctx.Done(), &header, req.Name, &out)
+	st := e.fs.Lookup(ctx.Done(), &header, req.Name, &out)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Lookup not implemented")
 	}
 	if st != fuse.OK {
 		return &pb.LookupResponse{Status: &pb.Status{Code: int32(st)}}, nil
 	}
+	e.sessions.lookup(ctx, out.NodeId)
 	return &pb.LookupResponse{
 		EntryOut: &pb.EntryOut{
 			NodeId:         out.NodeId,
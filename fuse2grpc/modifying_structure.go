@@ -29,6 +29,11 @@ import (
 )
 
 func (s *server) Mkdir(ctx context.Context, req *pb.MkdirRequest) (*pb.MkdirResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 		out    fuse.EntryOut
@@ -41,14 +46,23 @@ func (s *server) Mkdir(ctx context.Context, req *pb.MkdirRequest) (*pb.MkdirResp
 	}).Debug("Mknod")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Mkdir(ctx.Done(), &fuse.MkdirIn{InHeader: header, Mode: req.Mode, Umask: req.Umask}, req.Name, &out)
+	st := e.fs.Mkdir(ctx.Done(), &fuse.MkdirIn{InHeader: header, Mode: req.Mode, Umask: req.Umask}, req.Name, &out)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Mkdir not implemented")
 	}
+	if st == fuse.OK {
+		e.sessions.lookup(ctx, out.NodeId)
+		e.notifier.entry(ctx, req.Header.NodeId, req.Name)
+	}
 	return &pb.MkdirResponse{EntryOut: toPbEntryOut(&out), Status: &pb.Status{Code: int32(st)}}, nil
 }
 
 func (s *server) Unlink(ctx context.Context, req *pb.UnlinkRequest) (*pb.UnlinkResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -58,14 +72,22 @@ func (s *server) Unlink(ctx context.Context, req *pb.UnlinkRequest) (*pb.UnlinkR
 	}).Debug("Unlink")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Unlink(ctx.Done(), &header, req.Name)
+	st := e.fs.Unlink(ctx.Done(), &header, req.Name)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Unlink not implemented")
 	}
+	if st == fuse.OK {
+		e.notifier.entry(ctx, req.Header.NodeId, req.Name)
+	}
 	return &pb.UnlinkResponse{Status: &pb.Status{Code: int32(st)}}, nil
 }
 
 func (s *server) Rmdir(ctx context.Context, req *pb.RmdirRequest) (*pb.RmdirResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -75,14 +97,22 @@ func (s *server) Rmdir(ctx context.Context, req *pb.RmdirRequest) (*pb.RmdirResp
 	}).Debug("Rmdir")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Rmdir(ctx.Done(), &header, req.Name)
+	st := e.fs.Rmdir(ctx.Done(), &header, req.Name)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Rmdir not implemented")
 	}
+	if st == fuse.OK {
+		e.notifier.entry(ctx, req.Header.NodeId, req.Name)
+	}
 	return &pb.RmdirResponse{Status: &pb.Status{Code: int32(st)}}, nil
 }
 
 func (s *server) Rename(ctx context.Context, req *pb.RenameRequest) (*pb.RenameResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -95,9 +125,13 @@ func (s *server) Rename(ctx context.Context, req *pb.RenameRequest) (*pb.RenameR
 	}).Debug("Rename")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Rename(ctx.Done(), &fuse.RenameIn{InHeader: header, Newdir: req.Newdir, Flags: req.Flags, Padding: req.Padding}, req.OldName, req.NewName)
+	st := e.fs.Rename(ctx.Done(), &fuse.RenameIn{InHeader: header, Newdir: req.Newdir, Flags: req.Flags, Padding: req.Padding}, req.OldName, req.NewName)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
 	}
+	if st == fuse.OK {
+		e.notifier.entry(ctx, req.Header.NodeId, req.OldName)
+		e.notifier.entry(ctx, req.Newdir, req.NewName)
+	}
 	return &pb.RenameResponse{Status: &pb.Status{Code: int32(st)}}, nil
 }
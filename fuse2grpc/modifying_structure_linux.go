@@ -29,6 +29,11 @@ import (
 )
 
 func (s *server) Mknod(ctx context.Context, req *pb.MknodRequest) (*pb.MknodResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 		out    fuse.EntryOut
@@ -42,9 +47,13 @@ func (s *server) Mknod(ctx context.Context, req *pb.MknodRequest) (*pb.MknodResp
 	}).Debug("Mknod")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Mknod(ctx.Done(), &fuse.MknodIn{InHeader: header, Mode: req.Mode, Rdev: req.Rdev, Umask: req.Umask}, req.Name, &out)
+	st := e.fs.Mknod(ctx.Done(), &fuse.MknodIn{InHeader: header, Mode: req.Mode, Rdev: req.Rdev, Umask: req.Umask}, req.Name, &out)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Mknod not implemented")
 	}
+	if st == fuse.OK {
+		e.sessions.lookup(ctx, out.NodeId)
+		e.notifier.entry(ctx, req.Header.NodeId, req.Name)
+	}
 	return &pb.MknodResponse{EntryOut: toPbEntryOut(&out), Status: &pb.Status{Code: int32(st)}}, nil
 }
@@ -0,0 +1,150 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fuse2grpc
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+// notifyQueueSize is the number of events queued for a slow subscriber
+// before further events are dropped.
+const notifyQueueSize = 1024
+
+type subscriber struct {
+	clientID string
+	events   chan *pb.NotifyResponse
+}
+
+// notifier broadcasts the changes one client makes to a file system to
+// the other clients watching it, so that they can invalidate their
+// kernel caches.
+type notifier struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+func newNotifier() *notifier {
+	return &notifier{subscribers: make(map[*subscriber]struct{})}
+}
+
+// sameFS reports whether a and b are the same file system. Exports of
+// the same file system share their notifier.
+func sameFS(a, b fuse.RawFileSystem) bool {
+	ta := reflect.TypeOf(a)
+	return ta == reflect.TypeOf(b) && ta.Comparable() && a == b
+}
+
+func (n *notifier) subscribe(clientID string) *subscriber {
+	sub := &subscriber{clientID: clientID, events: make(chan *pb.NotifyResponse, notifyQueueSize)}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (n *notifier) unsubscribe(sub *subscriber) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.subscribers, sub)
+}
+
+// publish sends ev to every subscriber but the client calling with ctx,
+// whose kernel already knows about the change.
+func (n *notifier) publish(ctx context.Context, ev *pb.NotifyResponse) {
+	origin := utils.FromIncomingContext(ctx, utils.ClientIDKey)
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for sub := range n.subscribers {
+		if origin != "" && sub.clientID == origin {
+			continue
+		}
+		select {
+		case sub.events <- ev:
+		default:
+			grpc_logrus.Extract(ctx).WithFields(log.Fields{
+				"clientId": sub.clientID,
+			}).Warn("Notify queue full, dropping event")
+		}
+	}
+}
+
+// data invalidates length bytes of node at offset, all of its data if
+// length is 0.
+func (n *notifier) data(ctx context.Context, node uint64, offset, length int64) {
+	n.publish(ctx, &pb.NotifyResponse{Event: &pb.NotifyResponse_Inode{
+		Inode: &pb.InodeNotify{Node: node, Offset: offset, Length: length},
+	}})
+}
+
+// attr invalidates the attributes of node.
+func (n *notifier) attr(ctx context.Context, node uint64) {
+	n.data(ctx, node, -1, 0)
+}
+
+// entry invalidates name in parent, and the attributes of parent which
+// changed along with it.
+func (n *notifier) entry(ctx context.Context, parent uint64, name string) {
+	n.publish(ctx, &pb.NotifyResponse{Event: &pb.NotifyResponse_Entry{
+		Entry: &pb.EntryNotify{Parent: parent, Name: name},
+	}})
+	n.attr(ctx, parent)
+}
+
+func (s *server) Notify(req *pb.NotifyRequest, stream pb.RawFileSystem_NotifyServer) error {
+	ctx := stream.Context()
+
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return err
+	}
+
+	clientID := utils.FromIncomingContext(ctx, utils.ClientIDKey)
+	grpc_logrus.Extract(ctx).WithFields(log.Fields{
+		"clientId": clientID,
+	}).Debug("Notify")
+
+	sub := e.notifier.subscribe(clientID)
+	defer e.notifier.unsubscribe(sub)
+
+	// tell the client it is subscribed
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev := <-sub.events:
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+		}
+	}
+}
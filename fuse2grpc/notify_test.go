@@ -0,0 +1,66 @@
+package fuse2grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+func TestNotify(t *testing.T) {
+	server, fs := startTestServices(t, 0)
+	defer server.Stop()
+
+	client, conn := newRawFileSystemClient(t, serverSocketPath)
+	defer conn.Close()
+
+	ctx, cancel := Context()
+	defer cancel()
+
+	fs.EXPECT().Mkdir(gomock.Any(), gomock.Any(), "dir", gomock.Any()).DoAndReturn(
+		func(cancel <-chan struct{}, input *fuse.MkdirIn, name string, out *fuse.EntryOut) fuse.Status {
+			out.NodeId = 2
+			return fuse.OK
+		})
+	fs.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint32(5), fuse.OK)
+	fs.EXPECT().Forget(uint64(2), uint64(1)).AnyTimes()
+
+	subscribe := func(clientID string) (context.Context, pb.RawFileSystem_NotifyClient) {
+		ctx := metadata.AppendToOutgoingContext(ctx, utils.ClientIDKey, clientID)
+		stream, err := client.Notify(ctx, &pb.NotifyRequest{})
+		require.NoError(t, err)
+		_, err = stream.Header()
+		require.NoError(t, err)
+		return ctx, stream
+	}
+	ctxA, streamA := subscribe("a")
+	ctxB, streamB := subscribe("b")
+
+	_, err := client.Mkdir(ctxA, &pb.MkdirRequest{Header: TestInHeader, Name: "dir"})
+	require.NoError(t, err)
+
+	res, err := streamB.Recv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.GetEntry().Parent)
+	require.Equal(t, "dir", res.GetEntry().Name)
+	res, err = streamB.Recv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.GetInode().Node)
+	require.Equal(t, int64(-1), res.GetInode().Offset)
+
+	_, err = client.Write(ctxB, &pb.WriteRequest{Header: &pb.InHeader{NodeId: 3, Caller: TestCaller}, Offset: 10, Size: 5, Data: []byte("hello")})
+	require.NoError(t, err)
+
+	// a does not hear about its own mkdir
+	res, err = streamA.Recv()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.GetInode().Node)
+	require.Equal(t, int64(10), res.GetInode().Offset)
+	require.Equal(t, int64(5), res.GetInode().Length)
+}
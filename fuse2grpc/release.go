@@ -30,6 +30,11 @@ import (
 )
 
 func (s *server) Release(ctx context.Context, req *pb.ReleaseRequest) (*emptypb.Empty, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -42,11 +47,17 @@ func (s *server) Release(ctx context.Context, req *pb.ReleaseRequest) (*emptypb.
 	}).Debug("Release")
 
 	toFuseInHeader(req.Header, &header)
-	s.fs.Release(ctx.Done(), &fuse.ReleaseIn{InHeader: header, Fh: req.Fh, Flags: req.Flags, ReleaseFlags: req.ReleaseFlags, LockOwner: req.LockOwner})
+	e.sessions.release(ctx, req.Fh)
+	e.fs.Release(ctx.Done(), &fuse.ReleaseIn{InHeader: header, Fh: req.Fh, Flags: req.Flags, ReleaseFlags: req.ReleaseFlags, LockOwner: req.LockOwner})
 	return &emptypb.Empty{}, nil
 }
 
 func (s *server) Flush(ctx context.Context, req *pb.FlushRequest) (*pb.FlushResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -59,7 +70,7 @@ func (s *server) Flush(ctx context.Context, req *pb.FlushRequest) (*pb.FlushResp
 	}).Debug("OpenDir")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.Flush(ctx.Done(), &fuse.FlushIn{InHeader: header, Fh: req.Fh, Unused: req.Unused, Padding: req.Padding, LockOwner: req.LockOwner})
+	st := e.fs.Flush(ctx.Done(), &fuse.FlushIn{InHeader: header, Fh: req.Fh, Unused: req.Unused, Padding: req.Padding, LockOwner: req.LockOwner})
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
 	}
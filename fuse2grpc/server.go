@@ -18,11 +18,15 @@ package fuse2grpc
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
 	"github.com/hanwen/go-fuse/v2/fuse"
+	"google.golang.org/grpc/stats"
 
 	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
 )
 
 // msgSizeThreshold 1mb < default grpc message size limit 4mb
@@ -31,23 +35,67 @@ const msgSizeThreshold = 1 << 20
 type server struct {
 	pb.UnimplementedRawFileSystemServer
 
-	fs fuse.RawFileSystem
+	mu      sync.RWMutex
+	exports map[string]*export
 
 	buffers bufferPool
 
 	msgSizeThreshold int
+
+	sessionGrace time.Duration
+	nextConn     uint64
+
+	// features are the pb.Feature bits advertised by Init.
+	features uint64
 }
 
-// NewServer returns a new loopback server.
+// NewServer returns a new loopback server exporting fs as the default
+// export. Further file systems can be added with AddExport, fs may be
+// nil if there is no default export.
 func NewServer(fs fuse.RawFileSystem) *server {
-	return &server{fs: fs, buffers: bufferPool{}, msgSizeThreshold: msgSizeThreshold}
+	s := &server{
+		exports:          make(map[string]*export),
+		buffers:          bufferPool{},
+		msgSizeThreshold: msgSizeThreshold,
+		sessionGrace:     defaultSessionGracePeriod,
+		features:         utils.Features,
+	}
+	if fs != nil {
+		s.AddExport(DefaultExport, fs)
+	}
+	return s
 }
 
 func (s *server) SetMsgSizeThreshold(threshold int) {
 	s.msgSizeThreshold = threshold
 }
 
+// SetSessionGracePeriod sets how long the session of a client is kept
+// after its last connection is closed, so that a client reconnecting
+// keeps its handles. Sessions of clients without an id are always
+// released right away.
+func (s *server) SetSessionGracePeriod(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessionGrace = d
+	for _, e := range s.exports {
+		e.sessions.setGrace(d)
+	}
+}
+
+// StatsHandler returns the handler to install with grpc.StatsHandler.
+// It lets the server release the file handles, lookups and locks of a
+// client once all connections of the client are closed.
+func (s *server) StatsHandler() stats.Handler {
+	return &connStatsHandler{s: s}
+}
+
 func (s *server) String(ctx context.Context, req *pb.StringRequest) (*pb.StringResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	grpc_logrus.Extract(ctx).Debug("String")
-	return &pb.StringResponse{Value: s.fs.String()}, nil
+	return &pb.StringResponse{Value: e.fs.String()}, nil
 }
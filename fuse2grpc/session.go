@@ -0,0 +1,300 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fuse2grpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/stats"
+
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+// defaultSessionGracePeriod is how long a client may take to reconnect
+// before its session is released.
+const defaultSessionGracePeriod = 30 * time.Second
+
+type connIDKey struct{}
+
+type lockKey struct {
+	nodeId uint64
+	fh     uint64
+	owner  uint64
+}
+
+// session is what a client holds on the server: open file and
+// directory handles, lookup counts and POSIX locks.
+type session struct {
+	id    string
+	conns map[uint64]struct{}
+	// anonymous sessions are keyed by connection and end with it.
+	anonymous bool
+	// expire releases the session once the grace period is over.
+	expire *time.Timer
+
+	files   map[uint64]uint64 // fh -> nodeId
+	dirs    map[uint64]uint64 // fh -> nodeId
+	lookups map[uint64]uint64 // nodeId -> nlookup
+	locks   map[lockKey]struct{}
+}
+
+// sessionTracker keeps a session per client, identified by the
+// utils.ClientIDKey metadata or by the connection if that is missing.
+// When the last connection of a client goes away and the client does
+// not reconnect within the grace period, everything the client still
+// holds is released.
+type sessionTracker struct {
+	mu       sync.Mutex
+	fs       fuse.RawFileSystem
+	grace    time.Duration
+	sessions map[string]*session
+	conns    map[uint64]map[string]struct{}
+}
+
+func newSessionTracker(fs fuse.RawFileSystem) *sessionTracker {
+	return &sessionTracker{
+		fs:       fs,
+		grace:    defaultSessionGracePeriod,
+		sessions: make(map[string]*session),
+		conns:    make(map[uint64]map[string]struct{}),
+	}
+}
+
+func (t *sessionTracker) setGrace(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.grace = d
+}
+
+// get returns the session of the client calling with ctx, the caller
+// must hold t.mu.
+func (t *sessionTracker) get(ctx context.Context) *session {
+	connID, hasConn := ctx.Value(connIDKey{}).(uint64)
+
+	id := utils.FromIncomingContext(ctx, utils.ClientIDKey)
+	anonymous := id == ""
+	if anonymous {
+		id = fmt.Sprintf("conn-%d", connID)
+	}
+
+	sess, ok := t.sessions[id]
+	if !ok {
+		sess = &session{
+			id:        id,
+			anonymous: anonymous,
+			conns:     make(map[uint64]struct{}),
+			files:     make(map[uint64]uint64),
+			dirs:      make(map[uint64]uint64),
+			lookups:   make(map[uint64]uint64),
+			locks:     make(map[lockKey]struct{}),
+		}
+		t.sessions[id] = sess
+	}
+	if sess.expire != nil {
+		// the client is back in time
+		sess.expire.Stop()
+		sess.expire = nil
+	}
+	if hasConn {
+		if _, ok := sess.conns[connID]; !ok {
+			sess.conns[connID] = struct{}{}
+			if t.conns[connID] == nil {
+				t.conns[connID] = make(map[string]struct{})
+			}
+			t.conns[connID][id] = struct{}{}
+		}
+	}
+	return sess
+}
+
+func (t *sessionTracker) lookup(ctx context.Context, nodeId uint64) {
+	if nodeId == 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.get(ctx).lookups[nodeId]++
+}
+
+func (t *sessionTracker) forget(ctx context.Context, nodeId, nlookup uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sess := t.get(ctx)
+	if sess.lookups[nodeId] <= nlookup {
+		delete(sess.lookups, nodeId)
+		return
+	}
+	sess.lookups[nodeId] -= nlookup
+}
+
+func (t *sessionTracker) open(ctx context.Context, nodeId, fh uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.get(ctx).files[fh] = nodeId
+}
+
+func (t *sessionTracker) release(ctx context.Context, fh uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sess := t.get(ctx)
+	delete(sess.files, fh)
+	for k := range sess.locks {
+		if k.fh == fh {
+			delete(sess.locks, k)
+		}
+	}
+}
+
+func (t *sessionTracker) openDir(ctx context.Context, nodeId, fh uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.get(ctx).dirs[fh] = nodeId
+}
+
+func (t *sessionTracker) releaseDir(ctx context.Context, fh uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.get(ctx).dirs, fh)
+}
+
+// setLk records a lock, or forgets it if typ is F_UNLCK. Partial
+// unlocks keep the record, unlocking again on cleanup is harmless.
+func (t *sessionTracker) setLk(ctx context.Context, nodeId, fh, owner uint64, typ uint32, whole bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	k := lockKey{nodeId: nodeId, fh: fh, owner: owner}
+	if typ != syscall.F_UNLCK {
+		t.get(ctx).locks[k] = struct{}{}
+	} else if whole {
+		delete(t.get(ctx).locks, k)
+	}
+}
+
+// connEnd ends the sessions whose last connection was connID, sessions
+// of clients which may reconnect are ended after the grace period.
+func (t *sessionTracker) connEnd(connID uint64) {
+	var ended []*session
+
+	t.mu.Lock()
+	for id := range t.conns[connID] {
+		sess := t.sessions[id]
+		delete(sess.conns, connID)
+		if len(sess.conns) > 0 {
+			continue
+		}
+		if sess.anonymous || t.grace <= 0 {
+			delete(t.sessions, id)
+			ended = append(ended, sess)
+			continue
+		}
+		sess.expire = time.AfterFunc(t.grace, func() { t.expire(sess) })
+	}
+	delete(t.conns, connID)
+	t.mu.Unlock()
+
+	for _, sess := range ended {
+		t.cleanup(sess)
+	}
+}
+
+// expire ends sess unless the client has reconnected meanwhile.
+func (t *sessionTracker) expire(sess *session) {
+	t.mu.Lock()
+	if t.sessions[sess.id] != sess || len(sess.conns) > 0 {
+		t.mu.Unlock()
+		return
+	}
+	delete(t.sessions, sess.id)
+	t.mu.Unlock()
+
+	t.cleanup(sess)
+}
+
+// cleanup releases everything sess still holds: locks first, then file
+// and directory handles and finally the lookups.
+func (t *sessionTracker) cleanup(sess *session) {
+	if len(sess.locks)+len(sess.files)+len(sess.dirs)+len(sess.lookups) == 0 {
+		return
+	}
+	log.WithFields(log.Fields{
+		"client":  sess.id,
+		"locks":   len(sess.locks),
+		"files":   len(sess.files),
+		"dirs":    len(sess.dirs),
+		"lookups": len(sess.lookups),
+	}).Info("Client went away, releasing its session")
+
+	for k := range sess.locks {
+		t.fs.SetLk(nil, &fuse.LkIn{
+			InHeader: fuse.InHeader{NodeId: k.nodeId},
+			Fh:       k.fh,
+			Owner:    k.owner,
+			Lk:       fuse.FileLock{Start: 0, End: math.MaxInt64, Typ: syscall.F_UNLCK},
+		})
+	}
+	for fh, nodeId := range sess.files {
+		t.fs.Release(nil, &fuse.ReleaseIn{InHeader: fuse.InHeader{NodeId: nodeId}, Fh: fh})
+	}
+	for fh, nodeId := range sess.dirs {
+		t.fs.ReleaseDir(&fuse.ReleaseIn{InHeader: fuse.InHeader{NodeId: nodeId}, Fh: fh})
+	}
+	for nodeId, nlookup := range sess.lookups {
+		t.fs.Forget(nodeId, nlookup)
+	}
+}
+
+// connStatsHandler tags every connection, so sessions can be ended when
+// their connections go away.
+type connStatsHandler struct {
+	s *server
+}
+
+func (h *connStatsHandler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return context.WithValue(ctx, connIDKey{}, atomic.AddUint64(&h.s.nextConn, 1))
+}
+
+func (h *connStatsHandler) HandleConn(ctx context.Context, s stats.ConnStats) {
+	if _, ok := s.(*stats.ConnEnd); !ok {
+		return
+	}
+	if connID, ok := ctx.Value(connIDKey{}).(uint64); ok {
+		for _, e := range h.s.allExports() {
+			go e.sessions.connEnd(connID)
+		}
+	}
+}
+
+// TagRPC attaches the connection to the session of the client, so the
+// session lives as long as any connection the client made requests on.
+func (h *connStatsHandler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	if e, err := h.s.getExport(ctx); err == nil {
+		e.sessions.mu.Lock()
+		e.sessions.get(ctx)
+		e.sessions.mu.Unlock()
+	}
+	return ctx
+}
+
+func (h *connStatsHandler) HandleRPC(context.Context, stats.RPCStats) {}
@@ -0,0 +1,134 @@
+package fuse2grpc_test
+
+import (
+	"math"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+func TestSessionCleanupOnDisconnect(t *testing.T) {
+	server, fs := startTestServices(t, 0)
+	defer server.Stop()
+
+	client, conn := newRawFileSystemClient(t, serverSocketPath)
+
+	ctx, cancel := Context()
+	defer cancel()
+
+	fs.EXPECT().Lookup(gomock.Any(), gomock.Any(), "foo", gomock.Any()).DoAndReturn(
+		func(cancel <-chan struct{}, header *fuse.InHeader, name string, out *fuse.EntryOut) fuse.Status {
+			out.NodeId = 2
+			return fuse.OK
+		}).Times(2)
+	fs.EXPECT().Open(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(cancel <-chan struct{}, input *fuse.OpenIn, out *fuse.OpenOut) fuse.Status {
+			out.Fh = 3
+			return fuse.OK
+		})
+	fs.EXPECT().SetLk(gomock.Any(), gomock.Any()).Return(fuse.OK)
+	fs.EXPECT().Forget(uint64(2), uint64(1))
+
+	// The session holds the lock, the file handle and one lookup when
+	// the client goes away.
+	done := make(chan struct{})
+	gomock.InOrder(
+		fs.EXPECT().SetLk(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(cancel <-chan struct{}, input *fuse.LkIn) fuse.Status {
+				require.Equal(t, uint64(2), input.NodeId)
+				require.Equal(t, uint64(3), input.Fh)
+				require.Equal(t, uint64(4), input.Owner)
+				require.Equal(t, uint32(syscall.F_UNLCK), input.Lk.Typ)
+				require.Equal(t, uint64(math.MaxInt64), input.Lk.End)
+				return fuse.OK
+			}),
+		fs.EXPECT().Release(gomock.Any(), gomock.Any()).Do(
+			func(cancel <-chan struct{}, input *fuse.ReleaseIn) {
+				require.Equal(t, uint64(2), input.NodeId)
+				require.Equal(t, uint64(3), input.Fh)
+			}),
+		fs.EXPECT().Forget(uint64(2), uint64(1)).Do(func(nodeid, nlookup uint64) {
+			close(done)
+		}),
+	)
+
+	for i := 0; i < 2; i++ {
+		res, err := client.Lookup(ctx, &pb.LookupRequest{Header: TestInHeader, Name: "foo"})
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), res.EntryOut.NodeId)
+	}
+	_, err := client.Forget(ctx, &pb.ForgetRequest{Nodeid: 2, Nlookup: 1})
+	require.NoError(t, err)
+
+	_, err = client.Open(ctx, &pb.OpenRequest{OpenIn: &pb.OpenIn{Header: &pb.InHeader{NodeId: 2, Caller: TestCaller}}})
+	require.NoError(t, err)
+
+	_, err = client.SetLk(ctx, &pb.LkRequest{
+		Header: &pb.InHeader{NodeId: 2, Caller: TestCaller},
+		Fh:     3,
+		Owner:  4,
+		Lk:     &pb.FileLock{Start: 0, End: 10, Type: syscall.F_WRLCK},
+	})
+	require.NoError(t, err)
+
+	conn.Close()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("session was not cleaned up")
+	}
+}
+
+func TestSessionSurvivesReconnect(t *testing.T) {
+	server, fs := startTestServices(t, 0)
+	defer server.Stop()
+
+	ctx, cancel := Context()
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, utils.ClientIDKey, "test-client")
+
+	fs.EXPECT().Lookup(gomock.Any(), gomock.Any(), "foo", gomock.Any()).DoAndReturn(
+		func(cancel <-chan struct{}, header *fuse.InHeader, name string, out *fuse.EntryOut) fuse.Status {
+			out.NodeId = 2
+			return fuse.OK
+		})
+	fs.EXPECT().String().Return("test")
+
+	done := make(chan struct{})
+	fs.EXPECT().Forget(uint64(2), uint64(1)).Do(func(nodeid, nlookup uint64) {
+		close(done)
+	})
+
+	client, conn := newRawFileSystemClient(t, serverSocketPath)
+	_, err := client.Lookup(ctx, &pb.LookupRequest{Header: TestInHeader, Name: "foo"})
+	require.NoError(t, err)
+	conn.Close()
+
+	// Reconnecting within the grace period keeps the lookup.
+	client, conn = newRawFileSystemClient(t, serverSocketPath)
+	_, err = client.String(ctx, &pb.StringRequest{})
+	require.NoError(t, err)
+	time.Sleep(200 * time.Millisecond)
+
+	select {
+	case <-done:
+		t.Fatal("session was released while the client reconnected")
+	default:
+	}
+	conn.Close()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("session was not cleaned up")
+	}
+}
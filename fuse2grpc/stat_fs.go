@@ -29,6 +29,11 @@ import (
 )
 
 func (s *server) StatFs(ctx context.Context, req *pb.StatfsRequest) (*pb.StatfsResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		out    fuse.StatfsOut
 		header fuse.InHeader
@@ -38,7 +43,7 @@ func (s *server) StatFs(ctx context.Context, req *pb.StatfsRequest) (*pb.StatfsR
 	}).Debug("StatFs")
 	toFuseInHeader(req.Input, &header)
 
-	st := s.fs.StatFs(ctx.Done(), &header, &out)
+	st := e.fs.StatFs(ctx.Done(), &header, &out)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method StatFS not implemented")
 	}
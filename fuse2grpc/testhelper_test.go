@@ -14,6 +14,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/stats"
 
 	"github.com/chiyutianyi/grpcfuse/fuse2grpc"
 	"github.com/chiyutianyi/grpcfuse/mock"
@@ -44,7 +45,24 @@ func startTestServices(t *testing.T, msgSizeThreshold int) (*grpc.Server, *mock.
 
 	fs := mock.NewMockRawFileSystem(ctl)
 
-	server := NewTestGrpcServer(t, nil, nil)
+	s := fuse2grpc.NewServer(fs)
+	if msgSizeThreshold > 0 {
+		s.SetMsgSizeThreshold(msgSizeThreshold)
+	}
+	return serveTestServer(t, s), fs
+}
+
+type testServer interface {
+	pb.RawFileSystemServer
+
+	SetSessionGracePeriod(d time.Duration)
+	StatsHandler() stats.Handler
+}
+
+func serveTestServer(t *testing.T, s testServer) *grpc.Server {
+	s.SetSessionGracePeriod(100 * time.Millisecond)
+
+	server := NewTestGrpcServer(t, nil, nil, grpc.StatsHandler(s.StatsHandler()))
 
 	if err := os.RemoveAll(serverSocketPath); err != nil {
 		t.Fatal(err)
@@ -55,15 +73,11 @@ func startTestServices(t *testing.T, msgSizeThreshold int) (*grpc.Server, *mock.
 		t.Fatal("failed to start server")
 	}
 
-	s := fuse2grpc.NewServer(fs)
-	if msgSizeThreshold > 0 {
-		s.SetMsgSizeThreshold(msgSizeThreshold)
-	}
 	pb.RegisterRawFileSystemServer(server, s)
 	reflection.Register(server)
 
 	go server.Serve(listener)
-	return server, fs
+	return server
 }
 
 func newRawFileSystemClient(t *testing.T, serviceSocketPath string) (pb.RawFileSystemClient, *grpc.ClientConn) {
@@ -87,15 +101,15 @@ func Context() (context.Context, func()) {
 }
 
 // NewTestGrpcServer creates a GRPC Server for testing purposes
-func NewTestGrpcServer(t *testing.T, streamInterceptors []grpc.StreamServerInterceptor, unaryInterceptors []grpc.UnaryServerInterceptor) *grpc.Server {
+func NewTestGrpcServer(t *testing.T, streamInterceptors []grpc.StreamServerInterceptor, unaryInterceptors []grpc.UnaryServerInterceptor, opts ...grpc.ServerOption) *grpc.Server {
 	logger := NewTestLogger(t)
 	logrusEntry := log.NewEntry(logger).WithField("test", t.Name())
 	streamInterceptors = append([]grpc.StreamServerInterceptor{grpc_logrus.StreamServerInterceptor(logrusEntry)}, streamInterceptors...)
 	unaryInterceptors = append([]grpc.UnaryServerInterceptor{grpc_logrus.UnaryServerInterceptor(logrusEntry)}, unaryInterceptors...)
-	return grpc.NewServer(
+	return grpc.NewServer(append([]grpc.ServerOption{
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
-	)
+	}, opts...)...)
 }
 
 // NewTestLogger created a logrus hook which can be used with testing logs
@@ -29,6 +29,11 @@ import (
 )
 
 func (s *server) Write(ctx context.Context, req *pb.WriteRequest) (*pb.WriteResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -41,9 +46,16 @@ func (s *server) Write(ctx context.Context, req *pb.WriteRequest) (*pb.WriteResp
 	}).Debug("Write")
 	toFuseInHeader(req.Header, &header)
 
-	writen, st := s.fs.Write(ctx.Done(), &fuse.WriteIn{InHeader: header, Fh: req.Fh, Offset: req.Offset, Size: req.Size, WriteFlags: req.WriteFlags}, req.Data)
+	if !verifyWrite(ctx, "Write", req) {
+		return &pb.WriteResponse{Status: &pb.Status{Code: int32(fuse.EIO)}}, nil
+	}
+
+	writen, st := e.fs.Write(ctx.Done(), &fuse.WriteIn{InHeader: header, Fh: req.Fh, Offset: req.Offset, Size: req.Size, WriteFlags: req.WriteFlags}, req.Data)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
 	}
+	if st == fuse.OK {
+		e.notifier.data(ctx, req.Header.NodeId, int64(req.Offset), int64(writen))
+	}
 	return &pb.WriteResponse{Written: writen, Status: &pb.Status{Code: int32(st)}}, nil
 }
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fuse2grpc
+
+import (
+	"io"
+
+	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+// maxWriteStreamSize bounds the data of a WriteStream, which the server
+// gathers in memory. Kernels write at most 1MB at once, so larger
+// streams do not come from a mount.
+const maxWriteStreamSize = 16 * msgSizeThreshold
+
+func (s *server) WriteStream(stream pb.RawFileSystem_WriteStreamServer) error {
+	ctx := stream.Context()
+
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return status.Errorf(codes.InvalidArgument, "empty WriteStream")
+	}
+	if err != nil {
+		return err
+	}
+
+	// the declared size is checked before allocating anything, and the
+	// data received against it as it arrives
+	if req.Size > maxWriteStreamSize || len(req.Data) > int(req.Size) {
+		return stream.SendAndClose(&pb.WriteResponse{Status: &pb.Status{Code: int32(fuse.EINVAL)}})
+	}
+	data := s.buffers.AllocBuffer(req.Size)[:0]
+	defer s.buffers.FreeBuffer(data)
+
+	// every chunk carries the checksum of its own data
+	corrupted := !verifyWrite(ctx, "WriteStream", req)
+	data = append(data, req.Data...)
+	chunks := 1
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if len(data)+len(chunk.Data) > int(req.Size) {
+			return stream.SendAndClose(&pb.WriteResponse{Status: &pb.Status{Code: int32(fuse.EINVAL)}})
+		}
+		corrupted = corrupted || !verifyWrite(ctx, "WriteStream", chunk)
+		data = append(data, chunk.Data...)
+		chunks++
+	}
+	if corrupted {
+		return stream.SendAndClose(&pb.WriteResponse{Status: &pb.Status{Code: int32(fuse.EIO)}})
+	}
+
+	grpc_logrus.Extract(ctx).WithFields(log.Fields{
+		"nodeId": req.Header.NodeId,
+		"fh":     req.Fh,
+		"chunks": chunks,
+		"bytes":  len(data),
+	}).Debug("WriteStream")
+
+	req.Data = data
+	req.ChecksumType, req.Checksum = pb.ChecksumType_CHECKSUM_NONE, 0
+	res, err := s.Write(ctx, req)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(res)
+}
@@ -0,0 +1,69 @@
+package fuse2grpc_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+func TestWriteStream(t *testing.T) {
+	server, fs := startTestServices(t, 0)
+	defer server.Stop()
+
+	client, conn := newRawFileSystemClient(t, serverSocketPath)
+	defer conn.Close()
+
+	ctx, cancel := Context()
+	defer cancel()
+
+	fs.EXPECT().Write(gomock.Any(), gomock.Any(), []byte("hello world")).DoAndReturn(
+		func(cancel <-chan struct{}, input *fuse.WriteIn, data []byte) (uint32, fuse.Status) {
+			require.Equal(t, uint64(1), input.Fh)
+			require.Equal(t, uint64(4), input.Offset)
+			return uint32(len(data)), fuse.OK
+		})
+
+	stream, err := client.WriteStream(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, stream.Send(&pb.WriteRequest{Header: TestInHeader, Fh: 1, Offset: 4, Size: 11, Data: []byte("hello")}))
+	require.NoError(t, stream.Send(&pb.WriteRequest{Data: []byte(" worl")}))
+	require.NoError(t, stream.Send(&pb.WriteRequest{Data: []byte("d")}))
+
+	res, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+	require.Equal(t, int32(0), res.Status.Code)
+	require.Equal(t, uint32(11), res.Written)
+}
+
+func TestWriteStreamSize(t *testing.T) {
+	server, _ := startTestServices(t, 0)
+	defer server.Stop()
+
+	client, conn := newRawFileSystemClient(t, serverSocketPath)
+	defer conn.Close()
+
+	ctx, cancel := Context()
+	defer cancel()
+
+	// nothing is allocated for sizes beyond the limit
+	stream, err := client.WriteStream(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.WriteRequest{Header: TestInHeader, Fh: 1, Size: 1 << 31, Data: []byte("hello")}))
+	res, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+	require.Equal(t, int32(fuse.EINVAL), res.Status.Code)
+
+	// nor is more data than declared accepted
+	stream, err = client.WriteStream(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.WriteRequest{Header: TestInHeader, Fh: 1, Size: 5, Data: []byte("hello")}))
+	stream.Send(&pb.WriteRequest{Data: []byte(" world")})
+	res, err = stream.CloseAndRecv()
+	require.NoError(t, err)
+	require.Equal(t, int32(fuse.EINVAL), res.Status.Code)
+}
@@ -29,6 +29,11 @@ import (
 )
 
 func (s *server) GetXAttr(ctx context.Context, req *pb.GetXAttrRequest) (*pb.GetXAttrResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -39,7 +44,7 @@ func (s *server) GetXAttr(ctx context.Context, req *pb.GetXAttrRequest) (*pb.Get
 	}).Debug("GetXAttr")
 	toFuseInHeader(req.Header, &header)
 
-	sz, st := s.fs.GetXAttr(ctx.Done(), &header, req.Attr, req.Dest)
+	sz, st := e.fs.GetXAttr(ctx.Done(), &header, req.Attr, req.Dest)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method GetXAttr not implemented")
 	}
@@ -47,6 +52,11 @@ func (s *server) GetXAttr(ctx context.Context, req *pb.GetXAttrRequest) (*pb.Get
 }
 
 func (s *server) ListXAttr(ctx context.Context, req *pb.ListXAttrRequest) (*pb.ListXAttrResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -56,7 +66,7 @@ func (s *server) ListXAttr(ctx context.Context, req *pb.ListXAttrRequest) (*pb.L
 	}).Debug("ListXAttr")
 	toFuseInHeader(req.Header, &header)
 
-	sz, st := s.fs.ListXAttr(ctx.Done(), &header, req.Dest)
+	sz, st := e.fs.ListXAttr(ctx.Done(), &header, req.Dest)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method ListXAttr not implemented")
 	}
@@ -64,6 +74,11 @@ func (s *server) ListXAttr(ctx context.Context, req *pb.ListXAttrRequest) (*pb.L
 }
 
 func (s *server) RemoveXAttr(ctx context.Context, req *pb.RemoveXAttrRequest) (*pb.RemoveXAttrResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -73,9 +88,12 @@ func (s *server) RemoveXAttr(ctx context.Context, req *pb.RemoveXAttrRequest) (*
 	}).Debug("RemoveXAttr")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.RemoveXAttr(ctx.Done(), &header, req.Attr)
+	st := e.fs.RemoveXAttr(ctx.Done(), &header, req.Attr)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method RemoveXAttr not implemented")
 	}
+	if st == fuse.OK {
+		e.notifier.attr(ctx, req.Header.NodeId)
+	}
 	return &pb.RemoveXAttrResponse{Status: &pb.Status{Code: int32(st)}}, nil
 }
@@ -29,6 +29,11 @@ import (
 )
 
 func (s *server) SetXAttr(ctx context.Context, req *pb.SetXAttrRequest) (*pb.SetXAttrResponse, error) {
+	e, err := s.getExport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		header fuse.InHeader
 	)
@@ -40,9 +45,12 @@ func (s *server) SetXAttr(ctx context.Context, req *pb.SetXAttrRequest) (*pb.Set
 	}).Debug("SetXAttr")
 	toFuseInHeader(req.Header, &header)
 
-	st := s.fs.SetXAttr(ctx.Done(), &fuse.SetXAttrIn{InHeader: header, Size: req.Size, Flags: req.Flags}, req.Attr, req.Data)
+	st := e.fs.SetXAttr(ctx.Done(), &fuse.SetXAttrIn{InHeader: header, Size: req.Size, Flags: req.Flags}, req.Attr, req.Data)
 	if st == fuse.ENOSYS {
 		return nil, status.Errorf(codes.Unimplemented, "method GetXAttr not implemented")
 	}
+	if st == fuse.OK {
+		e.notifier.attr(ctx, req.Header.NodeId)
+	}
 	return &pb.SetXAttrResponse{Status: &pb.Status{Code: int32(st)}}, nil
 }
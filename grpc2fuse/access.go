@@ -23,7 +23,8 @@ import (
 )
 
 func (fs *fileSystem) Access(cancel <-chan struct{}, input *fuse.AccessIn) (code fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "Access")
+	defer done()
 
 	res, err := fs.client.Access(ctx, &pb.AccessRequest{
 		Header:  toPbHeader(&input.InHeader),
@@ -31,7 +32,7 @@ func (fs *fileSystem) Access(cancel <-chan struct{}, input *fuse.AccessIn) (code
 		Padding: input.Padding,
 	}, fs.opts...)
 
-	if st := dealGrpcError("Access", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Access", err); st != fuse.OK {
 		return st
 	}
 
@@ -17,18 +17,31 @@
 package grpc2fuse
 
 import (
+	"context"
+
 	"github.com/chiyutianyi/grpcfuse/pb"
 	"github.com/hanwen/go-fuse/v2/fuse"
+	"google.golang.org/grpc"
 )
 
 func (fs *fileSystem) GetAttr(cancel <-chan struct{}, in *fuse.GetAttrIn, out *fuse.AttrOut) (code fuse.Status) {
-	ctx := newContext(cancel)
+	if fs.cache.getAttr(in.NodeId, out) {
+		return fuse.OK
+	}
+	fs.writeBack.flushNode(in.NodeId)
 
-	res, err := fs.client.GetAttr(ctx, &pb.GetAttrRequest{
-		Header: toPbHeader(&in.InHeader),
-	}, fs.opts...)
+	ctx, done := fs.newRetryContext(cancel, "GetAttr")
+	defer done()
 
-	if st := dealGrpcError("GetAttr", err); st != fuse.OK {
+	var res *pb.GetAttrResponse
+	err := fs.retry(ctx, "GetAttr", func(ctx context.Context, opts []grpc.CallOption) (err error) {
+		res, err = fs.client.GetAttr(ctx, &pb.GetAttrRequest{
+			Header: toPbHeader(&in.InHeader),
+		}, opts...)
+		return err
+	})
+
+	if st := fs.dealGrpcError("GetAttr", err); st != fuse.OK {
 		return st
 	}
 
@@ -36,11 +49,14 @@ func (fs *fileSystem) GetAttr(cancel <-chan struct{}, in *fuse.GetAttrIn, out *f
 		return fuse.Status(res.Status.GetCode())
 	}
 	toFuseAttrOut(out, res.GetAttrOut())
+	fs.cache.setAttr(in.NodeId, out)
 	return fuse.OK
 }
 
 func (fs *fileSystem) SetAttr(cancel <-chan struct{}, in *fuse.SetAttrIn, out *fuse.AttrOut) (code fuse.Status) {
-	ctx := newContext(cancel)
+	fs.writeBack.flushNode(in.NodeId)
+	ctx, done := fs.newContext(cancel, "SetAttr")
+	defer done()
 
 	res, err := fs.client.SetAttr(ctx, &pb.SetAttrRequest{
 		Header:    toPbHeader(&in.InHeader),
@@ -63,8 +79,12 @@ func (fs *fileSystem) SetAttr(cancel <-chan struct{}, in *fuse.SetAttrIn, out *f
 		},
 		Unused5: in.Unused5,
 	}, fs.opts...)
+	fs.cache.dropAttr(in.NodeId)
+	if in.Valid&fuse.FATTR_SIZE != 0 {
+		fs.readCache.drop(in.NodeId)
+	}
 
-	if st := dealGrpcError("SetAttr", err); st != fuse.OK {
+	if st := fs.dealGrpcError("SetAttr", err); st != fuse.OK {
 		return st
 	}
 
@@ -0,0 +1,273 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc2fuse
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+const defaultCacheSize = 1 << 16
+
+type entryKey struct {
+	parent uint64
+	name   string
+}
+
+type cachedAttr struct {
+	attr    fuse.Attr
+	expires time.Time
+}
+
+type cachedEntry struct {
+	out     fuse.EntryOut
+	expires time.Time
+}
+
+// attrCache caches GetAttr and Lookup results by NodeId and (parent,
+// name) for as long as the server said they are valid.
+//
+// Every Lookup answered by the kernel increments the lookup count of
+// the node, which the kernel will eventually Forget. Lookups served from
+// the cache never reach the server, so they are counted in borrowed and
+// subtracted from the Forget forwarded to the server.
+//
+// All methods are safe to call on a nil *attrCache, which is how the
+// cache is disabled.
+type attrCache struct {
+	mu sync.Mutex
+
+	size     int
+	attrs    map[uint64]*cachedAttr
+	entries  map[entryKey]*cachedEntry
+	children map[uint64]map[entryKey]struct{}
+	borrowed map[uint64]uint64
+}
+
+func newAttrCache(size int) *attrCache {
+	if size <= 0 {
+		size = defaultCacheSize
+	}
+	return &attrCache{
+		size:     size,
+		attrs:    make(map[uint64]*cachedAttr),
+		entries:  make(map[entryKey]*cachedEntry),
+		children: make(map[uint64]map[entryKey]struct{}),
+		borrowed: make(map[uint64]uint64),
+	}
+}
+
+func validity(sec uint64, nsec uint32) time.Duration {
+	return time.Duration(sec)*time.Second + time.Duration(nsec)
+}
+
+func remaining(expires time.Time) (sec uint64, nsec uint32) {
+	d := time.Until(expires)
+	if d < 0 {
+		return 0, 0
+	}
+	return uint64(d / time.Second), uint32(d % time.Second)
+}
+
+// getAttr fills out with the cached attributes of node.
+func (c *attrCache) getAttr(node uint64, out *fuse.AttrOut) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	a, ok := c.attrs[node]
+	if !ok {
+		return false
+	}
+	if !time.Now().Before(a.expires) {
+		delete(c.attrs, node)
+		return false
+	}
+	out.Attr = a.attr
+	out.AttrValid, out.AttrValidNsec = remaining(a.expires)
+	return true
+}
+
+func (c *attrCache) setAttr(node uint64, out *fuse.AttrOut) {
+	if c == nil {
+		return
+	}
+	d := validity(out.AttrValid, out.AttrValidNsec)
+	if d <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shrink()
+	c.attrs[node] = &cachedAttr{attr: out.Attr, expires: time.Now().Add(d)}
+}
+
+// lookup fills out with the cached entry of name in parent. A hit is
+// counted as a lookup the server has not seen.
+func (c *attrCache) lookup(parent uint64, name string, out *fuse.EntryOut) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := entryKey{parent, name}
+	e, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	if !time.Now().Before(e.expires) {
+		c.deleteEntry(key)
+		return false
+	}
+	// The kernel applies the attributes of a lookup reply, so stale
+	// attributes must not be served along with a valid entry.
+	a, ok := c.attrs[e.out.NodeId]
+	if !ok || !time.Now().Before(a.expires) {
+		return false
+	}
+	*out = e.out
+	out.EntryValid, out.EntryValidNsec = remaining(e.expires)
+	out.Attr = a.attr
+	out.AttrValid, out.AttrValidNsec = remaining(a.expires)
+	c.borrowed[out.NodeId]++
+	return true
+}
+
+func (c *attrCache) setEntry(parent uint64, name string, out *fuse.EntryOut) {
+	if c == nil || out.NodeId == 0 {
+		return
+	}
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shrink()
+	if d := validity(out.AttrValid, out.AttrValidNsec); d > 0 {
+		c.attrs[out.NodeId] = &cachedAttr{attr: out.Attr, expires: now.Add(d)}
+	}
+	d := validity(out.EntryValid, out.EntryValidNsec)
+	if d <= 0 {
+		return
+	}
+	key := entryKey{parent, name}
+	c.deleteEntry(key)
+	c.entries[key] = &cachedEntry{out: *out, expires: now.Add(d)}
+	if c.children[out.NodeId] == nil {
+		c.children[out.NodeId] = make(map[entryKey]struct{})
+	}
+	c.children[out.NodeId][key] = struct{}{}
+}
+
+// dropAttr invalidates the cached attributes of the given nodes.
+func (c *attrCache) dropAttr(nodes ...uint64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, node := range nodes {
+		delete(c.attrs, node)
+	}
+}
+
+// dropEntry invalidates the entry of name in parent together with the
+// attributes of the parent and of the node the entry pointed to.
+func (c *attrCache) dropEntry(parent uint64, name string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := entryKey{parent, name}
+	if e, ok := c.entries[key]; ok {
+		delete(c.attrs, e.out.NodeId)
+	}
+	c.deleteEntry(key)
+	delete(c.attrs, parent)
+}
+
+// forget drops everything known about node and returns the part of
+// nlookup that has to be forwarded to the server.
+func (c *attrCache) forget(node, nlookup uint64) uint64 {
+	if c == nil {
+		return nlookup
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.attrs, node)
+	for key := range c.children[node] {
+		delete(c.entries, key)
+	}
+	delete(c.children, node)
+
+	b := c.borrowed[node]
+	if b > nlookup {
+		c.borrowed[node] = b - nlookup
+		return 0
+	}
+	delete(c.borrowed, node)
+	return nlookup - b
+}
+
+func (c *attrCache) deleteEntry(key entryKey) {
+	e, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	delete(c.entries, key)
+	if keys := c.children[e.out.NodeId]; keys != nil {
+		delete(keys, key)
+		if len(keys) == 0 {
+			delete(c.children, e.out.NodeId)
+		}
+	}
+}
+
+// shrink drops expired items when the cache is full, and everything if
+// that is not enough. Entries are dropped but borrowed lookups are kept,
+// they still have to be accounted for on Forget.
+func (c *attrCache) shrink() {
+	if len(c.attrs)+len(c.entries) < c.size {
+		return
+	}
+	now := time.Now()
+	for node, a := range c.attrs {
+		if !now.Before(a.expires) {
+			delete(c.attrs, node)
+		}
+	}
+	for key, e := range c.entries {
+		if !now.Before(e.expires) {
+			c.deleteEntry(key)
+		}
+	}
+	if len(c.attrs)+len(c.entries) < c.size {
+		return
+	}
+	c.attrs = make(map[uint64]*cachedAttr)
+	c.entries = make(map[entryKey]*cachedEntry)
+	c.children = make(map[uint64]map[entryKey]struct{})
+}
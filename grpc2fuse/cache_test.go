@@ -0,0 +1,56 @@
+package grpc2fuse_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+func TestCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+	fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithCache(0), grpc2fuse.WithForgetBatch(0, 0))
+	log.SetLevel(log.ErrorLevel)
+
+	entry := &pb.EntryOut{
+		NodeId:     2,
+		EntryValid: 60,
+		AttrValid:  60,
+		Attr:       &pb.Attr{Ino: 2, Size: 5, Mode: fuse.S_IFREG | 0644, Owner: &pb.Owner{}},
+	}
+
+	client.EXPECT().Lookup(gomock.Any(), gomock.Any()).Return(&pb.LookupResponse{EntryOut: entry, Status: &pb.Status{}}, nil).Times(2)
+
+	var out fuse.EntryOut
+	header := TestInHeader
+	for i := 0; i < 3; i++ {
+		require.Equal(t, fuse.OK, fs.Lookup(nil, &header, "foo", &out))
+		require.Equal(t, uint64(2), out.NodeId)
+		require.Equal(t, uint64(5), out.Size)
+	}
+
+	// attributes were cached by the lookup
+	var attr fuse.AttrOut
+	require.Equal(t, fuse.OK, fs.GetAttr(nil, &fuse.GetAttrIn{InHeader: fuse.InHeader{NodeId: 2}}, &attr))
+	require.Equal(t, uint64(5), attr.Size)
+
+	// two lookups were served from the cache, only one reached the server
+	client.EXPECT().Forget(gomock.Any(), &pb.ForgetRequest{Nodeid: 2, Nlookup: 1}).Return(nil, nil)
+	fs.Forget(2, 3)
+
+	// forget dropped the entry
+	require.Equal(t, fuse.OK, fs.Lookup(nil, &header, "foo", &out))
+
+	client.EXPECT().Unlink(gomock.Any(), gomock.Any()).Return(&pb.UnlinkResponse{Status: &pb.Status{}}, nil)
+	require.Equal(t, fuse.OK, fs.Unlink(nil, &header, "foo"))
+
+	client.EXPECT().Lookup(gomock.Any(), gomock.Any()).Return(&pb.LookupResponse{Status: &pb.Status{Code: int32(fuse.ENOENT)}}, nil)
+	require.Equal(t, fuse.ENOENT, fs.Lookup(nil, &header, "foo", &out))
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc2fuse
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/checksum"
+)
+
+// errChecksumMismatch is returned for data received with a wrong
+// checksum.
+var errChecksumMismatch = errors.New("checksum mismatch")
+
+// WithChecksum protects the data of reads and writes with checksums of
+// type t if the server supports them. Data received with a wrong
+// checksum fails with EIO, reads are retried once first.
+func WithChecksum(t pb.ChecksumType) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		fs.wantChecksum = t
+	}}
+}
+
+// selectChecksum enables the checksum asked for by WithChecksum if the
+// server supports it.
+func (fs *fileSystem) selectChecksum(res *pb.InitResponse) {
+	fs.checksum = pb.ChecksumType_CHECKSUM_NONE
+	if fs.wantChecksum == pb.ChecksumType_CHECKSUM_NONE {
+		return
+	}
+	if !checksum.Supported(fs.wantChecksum) {
+		log.Warnf("Unknown checksum %v, data is not checked", fs.wantChecksum)
+		return
+	}
+	if !fs.hasFeature(pb.Feature_FEATURE_CHECKSUMS) {
+		log.Warnf("Server does not support checksums, data is not checked")
+		return
+	}
+	fs.checksum = fs.wantChecksum
+}
+
+// signWrite sets the checksum of the data of req.
+func (fs *fileSystem) signWrite(req *pb.WriteRequest) {
+	req.ChecksumType = fs.checksum
+	req.Checksum = checksum.Sum(fs.checksum, req.Data)
+}
+
+// verifyRead checks the checksum of a buffer read at offset of node.
+func (fs *fileSystem) verifyRead(node, offset uint64, res *pb.ReadResponse) error {
+	if checksum.Verify(fs.checksum, res.Buffer, res.Checksum, "Read") {
+		return nil
+	}
+	log.WithFields(log.Fields{
+		"nodeId":   node,
+		"offset":   offset,
+		"bytes":    len(res.Buffer),
+		"checksum": fs.checksum,
+	}).Error("Checksum mismatch, data corrupted in transit")
+	return errChecksumMismatch
+}
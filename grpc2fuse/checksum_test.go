@@ -0,0 +1,79 @@
+package grpc2fuse_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/checksum"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+// checksumStream sends res in one message.
+type checksumStream struct {
+	grpc.ClientStream
+	res *pb.ReadResponse
+}
+
+func (s *checksumStream) Recv() (*pb.ReadResponse, error) {
+	if s.res == nil {
+		return nil, io.EOF
+	}
+	res := s.res
+	s.res = nil
+	return res, nil
+}
+
+func TestChecksum(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+
+	client.EXPECT().Init(gomock.Any(), gomock.Any()).Return(&pb.InitResponse{
+		Major:    utils.ProtocolMajor,
+		Features: utils.Features,
+	}, nil)
+	fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithChecksum(pb.ChecksumType_CHECKSUM_CRC32C))
+	require.NoError(t, fs.Handshake())
+
+	data := []byte("hello world")
+	sum := checksum.Sum(pb.ChecksumType_CHECKSUM_CRC32C, data)
+	reply := func(sum uint64) func(ctx context.Context, in *pb.ReadRequest, opts ...grpc.CallOption) (pb.RawFileSystem_ReadClient, error) {
+		return func(ctx context.Context, in *pb.ReadRequest, opts ...grpc.CallOption) (pb.RawFileSystem_ReadClient, error) {
+			require.Equal(t, pb.ChecksumType_CHECKSUM_CRC32C, in.ChecksumType)
+			return &checksumStream{res: &pb.ReadResponse{Status: &pb.Status{}, Buffer: data, Checksum: sum}}, nil
+		}
+	}
+	in := &fuse.ReadIn{InHeader: TestInHeader, Fh: 1, Size: uint32(len(data))}
+	buf := make([]byte, len(data))
+
+	// a corrupted read is retried once
+	gomock.InOrder(
+		client.EXPECT().Read(gomock.Any(), gomock.Any()).DoAndReturn(reply(sum+1)),
+		client.EXPECT().Read(gomock.Any(), gomock.Any()).DoAndReturn(reply(sum)),
+	)
+	res, st := fs.Read(nil, in, buf)
+	require.Equal(t, fuse.OK, st)
+	got, _ := res.Bytes(buf)
+	require.Equal(t, data, got)
+
+	client.EXPECT().Read(gomock.Any(), gomock.Any()).DoAndReturn(reply(sum + 1)).Times(2)
+	_, st = fs.Read(nil, in, buf)
+	require.Equal(t, fuse.EIO, st)
+
+	client.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *pb.WriteRequest, opts ...grpc.CallOption) (*pb.WriteResponse, error) {
+			require.Equal(t, pb.ChecksumType_CHECKSUM_CRC32C, in.ChecksumType)
+			require.Equal(t, sum, in.Checksum)
+			return &pb.WriteResponse{Status: &pb.Status{}, Written: uint32(len(in.Data))}, nil
+		})
+	_, st = fs.Write(nil, &fuse.WriteIn{InHeader: TestInHeader, Size: uint32(len(data))}, data)
+	require.Equal(t, fuse.OK, st)
+}
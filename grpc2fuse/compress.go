@@ -0,0 +1,75 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc2fuse
+
+import (
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+
+	"github.com/chiyutianyi/grpcfuse/compress"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+// defaultCompressThreshold is the payload size below which requests are
+// not compressed.
+const defaultCompressThreshold = 4 << 10
+
+// WithCompression compresses the data of Read, Write, ReadDir and
+// ReadDirPlus with the gRPC compressor name, one of compress.Names, if
+// the server supports it. Requests carrying or returning less than
+// threshold bytes are not compressed, 0 means defaultCompressThreshold.
+func WithCompression(name string, threshold int) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		if threshold <= 0 {
+			threshold = defaultCompressThreshold
+		}
+		fs.wantCompressor = name
+		fs.compressThreshold = threshold
+	}}
+}
+
+// selectCompressor picks the compressor asked for by WithCompression if
+// the server supports it.
+func (fs *fileSystem) selectCompressor(res *pb.InitResponse) {
+	fs.compressor = ""
+	if fs.wantCompressor == "" {
+		return
+	}
+	if !compress.Registered(fs.wantCompressor) {
+		log.Warnf("Unknown compressor %s, sending data uncompressed", fs.wantCompressor)
+		return
+	}
+	if utils.HasFeature(res.Features, pb.Feature_FEATURE_COMPRESSION) {
+		for _, name := range res.Compressors {
+			if name == fs.wantCompressor {
+				fs.compressor = name
+				return
+			}
+		}
+	}
+	log.Warnf("Server does not support compressor %s, sending data uncompressed", fs.wantCompressor)
+}
+
+// compressOpts returns opts compressing a request which carries or
+// returns size bytes of data.
+func (fs *fileSystem) compressOpts(opts []grpc.CallOption, size int) []grpc.CallOption {
+	if fs.compressor == "" || size < fs.compressThreshold {
+		return opts
+	}
+	return append(opts[:len(opts):len(opts)], grpc.UseCompressor(fs.compressor))
+}
@@ -0,0 +1,62 @@
+package grpc2fuse_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/chiyutianyi/grpcfuse/compress"
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+// compressor returns the compressor set by opts.
+func compressor(opts []grpc.CallOption) string {
+	for _, opt := range opts {
+		if c, ok := opt.(grpc.CompressorCallOption); ok {
+			return c.CompressorType
+		}
+	}
+	return ""
+}
+
+func TestCompression(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+
+	testcases := []struct {
+		compressors []string
+		size        int
+		compressor  string
+	}{
+		{[]string{compress.Zstd, compress.Gzip}, 100, compress.Zstd},
+		// below the threshold
+		{[]string{compress.Zstd}, 10, ""},
+		// not supported by the server
+		{[]string{compress.Gzip}, 100, ""},
+	}
+
+	for _, testcase := range testcases {
+		client.EXPECT().Init(gomock.Any(), gomock.Any()).Return(&pb.InitResponse{
+			Major:       utils.ProtocolMajor,
+			Features:    utils.Features,
+			Compressors: testcase.compressors,
+		}, nil)
+		fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithCompression(compress.Zstd, 64))
+		require.NoError(t, fs.Handshake())
+
+		client.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *pb.WriteRequest, opts ...grpc.CallOption) (*pb.WriteResponse, error) {
+				require.Equal(t, testcase.compressor, compressor(opts), testcase)
+				return &pb.WriteResponse{Status: &pb.Status{}, Written: uint32(len(in.Data))}, nil
+			})
+		_, st := fs.Write(nil, &fuse.WriteIn{InHeader: TestInHeader, Size: uint32(testcase.size)}, make([]byte, testcase.size))
+		require.Equal(t, fuse.OK, st)
+	}
+}
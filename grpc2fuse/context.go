@@ -18,17 +18,102 @@ package grpc2fuse
 
 import (
 	"context"
+	"time"
 )
 
-type fuseContext struct {
-	context.Context
-	cancel <-chan struct{}
+const (
+	// defaultTimeout bounds the requests without a timeout of their
+	// own.
+	defaultTimeout = time.Minute
+
+	shortTimeout = 15 * time.Second
+	longTimeout  = 10 * time.Minute
+)
+
+// defaultTimeouts are the per-method timeouts. Metadata requests are
+// answered quickly by a healthy server, while syncing may have to wait
+// for the disk and SetLkw for another lock holder.
+var defaultTimeouts = map[string]time.Duration{
+	"Access":    shortTimeout,
+	"GetAttr":   shortTimeout,
+	"GetXAttr":  shortTimeout,
+	"Init":      shortTimeout,
+	"ListXAttr": shortTimeout,
+	"Lookup":    shortTimeout,
+	"Readlink":  shortTimeout,
+	"StatFs":    shortTimeout,
+
+	"Flush":    longTimeout,
+	"Fsync":    longTimeout,
+	"FsyncDir": longTimeout,
+	"SetLkw":   longTimeout,
+}
+
+// WithDefaultTimeout sets the timeout of the requests without a timeout
+// set by WithTimeout. 0 lets them wait forever.
+func WithDefaultTimeout(d time.Duration) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		fs.defaultTimeout = d
+	}}
 }
 
-func newContext(cancel <-chan struct{}) *fuseContext {
-	return &fuseContext{Context: context.Background(), cancel: cancel}
+// WithTimeout sets the timeout of the requests made for the FUSE
+// operation method, e.g. "GetAttr" or "Fsync". 0 lets them wait
+// forever. Requests timing out fail with ETIMEDOUT. Requests retried by
+// WithRetryPolicy get the timeout for every attempt.
+func WithTimeout(method string, d time.Duration) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		fs.timeouts[method] = d
+	}}
 }
 
-func (ctx *fuseContext) Done() <-chan struct{} { return ctx.cancel }
+func (fs *fileSystem) timeout(method string) time.Duration {
+	if d, ok := fs.timeouts[method]; ok {
+		return d
+	}
+	return fs.defaultTimeout
+}
+
+// newContext returns the context of a request made for the FUSE
+// operation method. It is canceled when the kernel interrupts the
+// operation through cancel, when the timeout of method expires or when
+// the file system is closed. cancel may be nil for requests not bound to
+// a kernel request. The returned function must be called once the
+// request is done.
+func (fs *fileSystem) newContext(cancel <-chan struct{}, method string) (context.Context, context.CancelFunc) {
+	return fs.withCancel(cancel, fs.timeout(method))
+}
 
-func (ctx *fuseContext) Err() error { return context.Canceled }
+// newRetryContext is newContext for requests made through retry, which
+// applies the timeout of method to every attempt. The retry policy
+// bounds how long they are tried all together.
+func (fs *fileSystem) newRetryContext(cancel <-chan struct{}, method string) (context.Context, context.CancelFunc) {
+	if fs.retryPolicy == nil || !idempotent[method] {
+		return fs.newContext(cancel, method)
+	}
+	return fs.withCancel(cancel, 0)
+}
+
+// withCancel returns a context canceled through cancel, after d unless
+// d is 0, or when the file system is closed.
+func (fs *fileSystem) withCancel(cancel <-chan struct{}, d time.Duration) (context.Context, context.CancelFunc) {
+	var (
+		ctx  context.Context
+		stop context.CancelFunc
+	)
+	if d > 0 {
+		ctx, stop = context.WithTimeout(fs.ctx, d)
+	} else {
+		ctx, stop = context.WithCancel(fs.ctx)
+	}
+	if cancel != nil {
+		go func() {
+			select {
+			case <-cancel:
+				stop()
+			case <-ctx.Done():
+			}
+		}()
+	}
+	return ctx, stop
+}
@@ -0,0 +1,52 @@
+package grpc2fuse_test
+
+import (
+	"context"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+func TestContext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+
+	// blocks until the request is canceled or times out
+	block := func(ctx context.Context, in *pb.GetAttrRequest, opts ...grpc.CallOption) (*pb.GetAttrResponse, error) {
+		require.NoError(t, ctx.Err())
+		<-ctx.Done()
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	var out fuse.AttrOut
+
+	fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithTimeout("GetAttr", 10*time.Millisecond))
+	client.EXPECT().GetAttr(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *pb.GetAttrRequest, opts ...grpc.CallOption) (*pb.GetAttrResponse, error) {
+			deadline, ok := ctx.Deadline()
+			require.True(t, ok)
+			require.WithinDuration(t, time.Now().Add(10*time.Millisecond), deadline, time.Second)
+			return block(ctx, in, opts...)
+		})
+	require.Equal(t, fuse.Status(syscall.ETIMEDOUT), fs.GetAttr(nil, &fuse.GetAttrIn{InHeader: TestInHeader}, &out))
+
+	fs = grpc2fuse.NewFileSystem(client, grpc2fuse.WithTimeout("GetAttr", 0))
+	cancel := make(chan struct{})
+	client.EXPECT().GetAttr(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *pb.GetAttrRequest, opts ...grpc.CallOption) (*pb.GetAttrResponse, error) {
+			_, ok := ctx.Deadline()
+			require.False(t, ok)
+			close(cancel)
+			return block(ctx, in, opts...)
+		})
+	require.Equal(t, fuse.EINTR, fs.GetAttr(cancel, &fuse.GetAttrIn{InHeader: TestInHeader}, &out))
+}
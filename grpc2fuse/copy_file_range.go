@@ -23,7 +23,9 @@ import (
 )
 
 func (fs *fileSystem) CopyFileRange(cancel <-chan struct{}, input *fuse.CopyFileRangeIn) (written uint32, code fuse.Status) {
-	ctx := newContext(cancel)
+	fs.writeBack.flushNode(input.NodeId, input.NodeIdOut)
+	ctx, done := fs.newContext(cancel, "CopyFileRange")
+	defer done()
 
 	res, err := fs.client.CopyFileRange(ctx, &pb.CopyFileRangeRequest{
 		Header:    toPbHeader(&input.InHeader),
@@ -35,8 +37,10 @@ func (fs *fileSystem) CopyFileRange(cancel <-chan struct{}, input *fuse.CopyFile
 		Len:       input.Len,
 		Flags:     input.Flags,
 	}, fs.opts...)
+	fs.cache.dropAttr(input.NodeIdOut)
+	fs.readCache.drop(input.NodeIdOut)
 
-	if st := dealGrpcError("CopyFileRange", err); st != fuse.OK {
+	if st := fs.dealGrpcError("CopyFileRange", err); st != fuse.OK {
 		return 0, st
 	}
 
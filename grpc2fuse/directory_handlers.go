@@ -21,13 +21,16 @@ import (
 	"io"
 
 	"github.com/chiyutianyi/grpcfuse/pb"
-	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hanwen/go-fuse/v2/fuse"
 )
 
 func (fs *fileSystem) OpenDir(cancel <-chan struct{}, in *fuse.OpenIn, out *fuse.OpenOut) (status fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "OpenDir")
+	defer done()
 
 	res, err := fs.client.OpenDir(ctx, &pb.OpenDirRequest{
 		OpenIn: &pb.OpenIn{
@@ -37,7 +40,7 @@ func (fs *fileSystem) OpenDir(cancel <-chan struct{}, in *fuse.OpenIn, out *fuse
 		},
 	}, fs.opts...)
 
-	if st := dealGrpcError("OpenDir", err); st != fuse.OK {
+	if st := fs.dealGrpcError("OpenDir", err); st != fuse.OK {
 		return st
 	}
 
@@ -55,89 +58,120 @@ func (fs *fileSystem) doReadDir(
 	cancel <-chan struct{},
 	in *fuse.ReadIn,
 	out *fuse.DirEntryList,
-	reader func(ctx context.Context, in *pb.ReadDirRequest) RawFileSystem_ReadDirClient,
+	reader func(ctx context.Context, in *pb.ReadDirRequest, opts ...grpc.CallOption) (RawFileSystem_ReadDirClient, error),
 	funcName string,
+	plus bool,
 ) fuse.Status {
-	var de fuse.DirEntry
-	ctx := newContext(cancel)
-
-	stream := reader(ctx, &pb.ReadDirRequest{ReadIn: toPbReadIn(in)})
+	var (
+		added, full bool
+		code        int32
+	)
+	ctx, done := fs.newRetryContext(cancel, funcName)
+	defer done()
 
-	for {
-		res, err := stream.Recv()
-		if err == io.EOF {
-			break
+	err := fs.retry(ctx, funcName, func(ctx context.Context, opts []grpc.CallOption) error {
+		stream, err := reader(ctx, &pb.ReadDirRequest{ReadIn: toPbReadIn(in)}, fs.compressOpts(opts, int(in.Size))...)
+		if err != nil {
+			return err
 		}
-		if st := dealGrpcError(funcName, err); st != fuse.OK {
-			return st
-		}
-		if res.Status.GetCode() != 0 {
-			return fuse.Status(res.Status.GetCode())
-		}
-		for _, e := range res.Entries {
-			de.Ino = e.Ino
-			de.Name = string(e.Name)
-			de.Mode = e.Mode
-			if !out.AddDirEntry(de) {
-				break
+
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				if added {
+					// entries can not be taken back, so
+					// the stream must not be retried.
+					return status.Error(codes.Aborted, err.Error())
+				}
+				return err
+			}
+			if code = res.Status.GetCode(); code != 0 {
+				return nil
+			}
+			for _, e := range res.Entries {
+				if !full {
+					full = !fs.addDirEntry(out, in.NodeId, e, plus)
+					added = added || !full
+				}
+				if full && e.EntryOut.GetNodeId() != 0 {
+					// the server looked up an entry the
+					// kernel will never hear of
+					fs.queueForget(e.EntryOut.NodeId, 1)
+				}
 			}
 		}
+	})
+
+	if st := fs.dealGrpcError(funcName, err); st != fuse.OK {
+		return st
 	}
-	return fuse.OK
+	return fuse.Status(code)
 }
 
-func (fs *fileSystem) ReadDir(cancel <-chan struct{}, in *fuse.ReadIn, out *fuse.DirEntryList) fuse.Status {
-	var (
-		stream pb.RawFileSystem_ReadDirClient
-		err    error
-	)
+// addDirEntry adds e to out, together with the result of its lookup for
+// ReadDirPlus. It returns false if out is full.
+func (fs *fileSystem) addDirEntry(out *fuse.DirEntryList, parent uint64, e *pb.DirEntry, plus bool) bool {
+	de := fuse.DirEntry{Ino: e.Ino, Name: string(e.Name), Mode: e.Mode}
+	if !plus {
+		return out.AddDirEntry(de)
+	}
 
-	reader := func(ctx context.Context, in *pb.ReadDirRequest) RawFileSystem_ReadDirClient {
-		stream, err = fs.client.ReadDir(ctx, in, fs.opts...)
-		return stream
+	entryOut := out.AddDirLookupEntry(de)
+	if entryOut == nil {
+		return false
+	}
+	// servers before EntryOut leave the entry zero, which tells the
+	// kernel nothing was looked up
+	if e.EntryOut.GetNodeId() != 0 {
+		toFuseEntryOut(entryOut, e.EntryOut)
+		fs.cache.setEntry(parent, de.Name, entryOut)
 	}
+	return true
+}
 
-	if err != nil {
-		log.Errorf("ReadDir: %v", err)
-		return fuse.EIO
+func (fs *fileSystem) ReadDir(cancel <-chan struct{}, in *fuse.ReadIn, out *fuse.DirEntryList) fuse.Status {
+	reader := func(ctx context.Context, in *pb.ReadDirRequest, opts ...grpc.CallOption) (RawFileSystem_ReadDirClient, error) {
+		return fs.client.ReadDir(ctx, in, opts...)
 	}
 
-	return fs.doReadDir(cancel, in, out, reader, "ReadDir")
+	return fs.doReadDir(cancel, in, out, reader, "ReadDir", false)
 }
 
 func (fs *fileSystem) ReadDirPlus(cancel <-chan struct{}, in *fuse.ReadIn, out *fuse.DirEntryList) fuse.Status {
-	var (
-		stream pb.RawFileSystem_ReadDirPlusClient
-		err    error
-	)
-
-	reader := func(ctx context.Context, in *pb.ReadDirRequest) RawFileSystem_ReadDirClient {
-		stream, err = fs.client.ReadDirPlus(ctx, in, fs.opts...)
-		return stream
+	reader := func(ctx context.Context, in *pb.ReadDirRequest, opts ...grpc.CallOption) (RawFileSystem_ReadDirClient, error) {
+		return fs.client.ReadDirPlus(ctx, in, opts...)
 	}
-
-	if err != nil {
-		log.Errorf("ReadDirPlus: %v", err)
-		return fuse.EIO
+	if !fs.hasFeature(pb.Feature_FEATURE_READDIRPLUS_ENTRIES) {
+		// the entries of the server are looked up for nothing, the
+		// kernel looks them up again
+		reader = func(ctx context.Context, in *pb.ReadDirRequest, opts ...grpc.CallOption) (RawFileSystem_ReadDirClient, error) {
+			return fs.client.ReadDir(ctx, in, opts...)
+		}
 	}
 
-	return fs.doReadDir(cancel, in, out, reader, "ReadDirPlus")
+	return fs.doReadDir(cancel, in, out, reader, "ReadDirPlus", true)
 }
 
 func (fs *fileSystem) ReleaseDir(in *fuse.ReleaseIn) {
-	if _, err := fs.client.ReleaseDir(context.TODO(), &pb.ReleaseRequest{
+	ctx, done := fs.newContext(nil, "ReleaseDir")
+	defer done()
+	if _, err := fs.client.ReleaseDir(ctx, &pb.ReleaseRequest{
 		Header:       toPbHeader(&in.InHeader),
 		Fh:           in.Fh,
 		Flags:        in.Flags,
 		ReleaseFlags: in.ReleaseFlags,
 		LockOwner:    in.LockOwner,
 	}, fs.opts...); err != nil {
-		dealGrpcError("ReleaseDir", err)
+		fs.dealGrpcError("ReleaseDir", err)
 	}
 }
 
 func (fs *fileSystem) FsyncDir(cancel <-chan struct{}, input *fuse.FsyncIn) (code fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "FsyncDir")
+	defer done()
 
 	res, err := fs.client.FsyncDir(ctx, &pb.FsyncRequest{
 		Header:     toPbHeader(&input.InHeader),
@@ -146,7 +180,7 @@ func (fs *fileSystem) FsyncDir(cancel <-chan struct{}, input *fuse.FsyncIn) (cod
 		Padding:    input.Padding,
 	}, fs.opts...)
 
-	if st := dealGrpcError("FsyncDir", err); st != fuse.OK {
+	if st := fs.dealGrpcError("FsyncDir", err); st != fuse.OK {
 		return st
 	}
 
@@ -0,0 +1,57 @@
+package grpc2fuse_test
+
+import (
+	"io"
+	"testing"
+	"unsafe"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+func TestReadDirPlus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+	stream := mock.NewMockRawFileSystem_ReadDirPlusClient(ctrl)
+	fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithCache(0), grpc2fuse.WithForgetBatch(0, 0))
+
+	entry := func(name string, ino uint64) *pb.DirEntry {
+		return &pb.DirEntry{Name: []byte(name), Mode: fuse.S_IFREG, Ino: ino, EntryOut: &pb.EntryOut{
+			NodeId:     ino,
+			EntryValid: 60,
+			AttrValid:  60,
+			Attr:       &pb.Attr{Ino: ino, Size: 5, Mode: fuse.S_IFREG | 0644, Owner: &pb.Owner{}},
+		}}
+	}
+
+	client.EXPECT().ReadDirPlus(gomock.Any(), gomock.Any()).Return(stream, nil)
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(&pb.ReadDirResponse{
+			Status:  &pb.Status{},
+			Entries: []*pb.DirEntry{entry("foo", 2), entry("bar", 3)},
+		}, nil),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+	// bar does not fit, so its lookup is given back
+	client.EXPECT().Forget(gomock.Any(), &pb.ForgetRequest{Nodeid: 3, Nlookup: 1}).Return(nil, nil)
+
+	// room for exactly one entry
+	buf := make([]byte, unsafe.Sizeof(fuse.EntryOut{})+24+8)
+	header := TestInHeader
+	st := fs.ReadDirPlus(nil, &fuse.ReadIn{InHeader: header, Size: uint32(len(buf))}, fuse.NewDirEntryList(buf, 0))
+	require.Equal(t, fuse.OK, st)
+
+	out := (*fuse.EntryOut)(unsafe.Pointer(&buf[0]))
+	require.Equal(t, uint64(2), out.NodeId)
+	require.Equal(t, uint64(5), out.Size)
+
+	// foo was cached, looking it up does not reach the server
+	var lookup fuse.EntryOut
+	require.Equal(t, fuse.OK, fs.Lookup(nil, &header, "foo", &lookup))
+	require.Equal(t, uint64(2), lookup.NodeId)
+}
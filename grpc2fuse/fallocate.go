@@ -23,7 +23,9 @@ import (
 )
 
 func (fs *fileSystem) Fallocate(cancel <-chan struct{}, input *fuse.FallocateIn) (code fuse.Status) {
-	ctx := newContext(cancel)
+	fs.writeBack.flushNode(input.NodeId)
+	ctx, done := fs.newContext(cancel, "Fallocate")
+	defer done()
 
 	res, err := fs.client.Fallocate(ctx, &pb.FallocateRequest{
 		Header:  toPbHeader(&input.InHeader),
@@ -33,8 +35,10 @@ func (fs *fileSystem) Fallocate(cancel <-chan struct{}, input *fuse.FallocateIn)
 		Mode:    input.Mode,
 		Padding: input.Padding,
 	}, fs.opts...)
+	fs.cache.dropAttr(input.NodeId)
+	fs.readCache.drop(input.NodeId)
 
-	if st := dealGrpcError("Fallocate", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Fallocate", err); st != fuse.OK {
 		return st
 	}
 
@@ -17,15 +17,20 @@
 package grpc2fuse
 
 import (
+	"context"
 	"io"
+	"syscall"
 
 	"github.com/chiyutianyi/grpcfuse/pb"
 
 	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 )
 
 func (fs *fileSystem) Open(cancel <-chan struct{}, in *fuse.OpenIn, out *fuse.OpenOut) (status fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "Open")
+	defer done()
 
 	res, err := fs.client.Open(ctx, &pb.OpenRequest{
 		OpenIn: &pb.OpenIn{
@@ -35,48 +40,104 @@ func (fs *fileSystem) Open(cancel <-chan struct{}, in *fuse.OpenIn, out *fuse.Op
 		},
 	}, fs.opts...)
 
-	if st := dealGrpcError("Open", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Open", err); st != fuse.OK {
 		return st
 	}
 	if res.Status.GetCode() != 0 {
 		return fuse.Status(res.Status.GetCode())
 	}
+	if in.Flags&syscall.O_TRUNC != 0 {
+		fs.readCache.drop(in.NodeId)
+	}
 
 	toFuseOpenOut(out, res.OpenOut)
 	return fuse.OK
 }
 
 func (fs *fileSystem) Read(cancel <-chan struct{}, input *fuse.ReadIn, buf []byte) (fuse.ReadResult, fuse.Status) {
-	ctx := newContext(cancel)
-
-	stream, err := fs.client.Read(ctx, &pb.ReadRequest{ReadIn: toPbReadIn(input)}, fs.opts...)
+	fs.writeBack.flushNode(input.NodeId)
+	if n, st, ok := fs.readCache.read(cancel, input, buf, fs.prefetch); ok {
+		if st != fuse.OK {
+			return nil, st
+		}
+		return fuse.ReadResultData(buf[:n]), fuse.OK
+	}
 
-	if st := dealGrpcError("Read", err); st != fuse.OK {
+	ctx, done := fs.newRetryContext(cancel, "Read")
+	defer done()
+	rs, st := fs.doRead(ctx, input)
+	if st != fuse.OK {
 		return nil, st
 	}
+	return fuse.ReadResultData(rs), fuse.OK
+}
 
-	var rs []byte
+// prefetch reads a window for the read cache. It is not bound to a
+// kernel request.
+func (fs *fileSystem) prefetch(input *fuse.ReadIn) ([]byte, fuse.Status) {
+	ctx, done := fs.newRetryContext(nil, "Read")
+	defer done()
+	return fs.doRead(ctx, input)
+}
 
-	for {
-		res, err := stream.Recv()
-		if err == io.EOF {
-			break
+func (fs *fileSystem) doRead(ctx context.Context, input *fuse.ReadIn) ([]byte, fuse.Status) {
+	var (
+		rs   []byte
+		code int32
+	)
+
+	read := func(ctx context.Context, opts []grpc.CallOption) error {
+		rs = rs[:0]
+
+		stream, err := fs.client.Read(ctx, &pb.ReadRequest{
+			ReadIn:       toPbReadIn(input),
+			ChecksumType: fs.checksum,
+		}, fs.compressOpts(opts, int(input.Size))...)
+		if err != nil {
+			return err
 		}
-		if st := dealGrpcError("Read", err); st != fuse.OK {
-			return nil, st
-		}
-		if res.Status.GetCode() != 0 {
-			return nil, fuse.Status(res.Status.GetCode())
+
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if code = res.Status.GetCode(); code != 0 {
+				return nil
+			}
+			if err := fs.verifyRead(input.NodeId, input.Offset+uint64(len(rs)), res); err != nil {
+				return err
+			}
+
+			rs = append(rs, res.Buffer...)
 		}
+	}
 
-		rs = append(rs, res.Buffer...)
+	err := fs.retry(ctx, "Read", read)
+	if err == errChecksumMismatch {
+		log.Warnf("Read: retrying after checksum mismatch")
+		err = fs.retry(ctx, "Read", read)
+	}
+	if err == errChecksumMismatch {
+		return nil, fuse.EIO
 	}
 
-	return fuse.ReadResultData(rs), fuse.OK
+	if st := fs.dealGrpcError("Read", err); st != fuse.OK {
+		return nil, st
+	}
+	if code != 0 {
+		return nil, fuse.Status(code)
+	}
+	return rs, fuse.OK
 }
 
 func (fs *fileSystem) Lseek(cancel <-chan struct{}, in *fuse.LseekIn, out *fuse.LseekOut) fuse.Status {
-	ctx := newContext(cancel)
+	fs.writeBack.flushNode(in.NodeId)
+	ctx, done := fs.newContext(cancel, "Lseek")
+	defer done()
 
 	res, err := fs.client.Lseek(ctx,
 		&pb.LseekRequest{
@@ -87,7 +148,7 @@ func (fs *fileSystem) Lseek(cancel <-chan struct{}, in *fuse.LseekIn, out *fuse.
 			Padding: in.Padding,
 		}, fs.opts...)
 
-	if st := dealGrpcError("Lseek", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Lseek", err); st != fuse.OK {
 		return st
 	}
 	if res.Status.GetCode() != 0 {
@@ -23,7 +23,8 @@ import (
 )
 
 func (fs *fileSystem) Create(cancel <-chan struct{}, input *fuse.CreateIn, name string, out *fuse.CreateOut) (code fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "Create")
+	defer done()
 
 	res, err := fs.client.Create(ctx, &pb.CreateRequest{
 		Header:  toPbHeader(&input.InHeader),
@@ -34,7 +35,7 @@ func (fs *fileSystem) Create(cancel <-chan struct{}, input *fuse.CreateIn, name
 		Padding: input.Padding,
 	}, fs.opts...)
 
-	if st := dealGrpcError("Create", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Create", err); st != fuse.OK {
 		return st
 	}
 	if res.Status.GetCode() != 0 {
@@ -42,5 +43,7 @@ func (fs *fileSystem) Create(cancel <-chan struct{}, input *fuse.CreateIn, name
 	}
 	toFuseEntryOut(&out.EntryOut, res.EntryOut)
 	toFuseOpenOut(&out.OpenOut, res.OpenOut)
+	fs.cache.dropAttr(input.NodeId)
+	fs.cache.setEntry(input.NodeId, name, &out.EntryOut)
 	return fuse.Status(res.Status.GetCode())
 }
@@ -18,16 +18,24 @@ package grpc2fuse
 
 import (
 	"context"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/hanwen/go-fuse/v2/fuse"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
 )
 
 const (
 	defaultName = "grpcfuse"
+
+	// msgSizeThreshold 1mb < default grpc message size limit 4mb
+	msgSizeThreshold = 1 << 20
 )
 
 type fileSystem struct {
@@ -35,22 +43,104 @@ type fileSystem struct {
 
 	client pb.RawFileSystemClient
 	opts   []grpc.CallOption
+
+	// ctx carries the client id and the export to the server, every
+	// request is made with a context derived from it. Close cancels
+	// it.
+	ctx      context.Context
+	cancel   context.CancelFunc
+	clientID string
+	export   string
+
+	cache     *attrCache
+	readCache *readCache
+	writeBack *writeBack
+
+	msgSizeThreshold int
+	noWriteStream    int32
+
+	// compressor is the compressor selected by Handshake out of
+	// wantCompressor.
+	wantCompressor    string
+	compressor        string
+	compressThreshold int
+
+	// checksum is the checksum selected by Handshake out of
+	// wantChecksum.
+	wantChecksum pb.ChecksumType
+	checksum     pb.ChecksumType
+
+	forgets       forgetBatch
+	noBatchForget int32
+
+	retryPolicy      *RetryPolicy
+	unavailableErrno syscall.Errno
+
+	defaultTimeout time.Duration
+	timeouts       map[string]time.Duration
+
+	// features are the pb.Feature bits supported by the server, set
+	// by Handshake.
+	features    uint64
+	serverMajor uint32
+	handshakeMu sync.Mutex
+	handshaked  bool
+
+	mountOptions *fuse.MountOptions
 }
 
-// NewFileSystem creates a new file system.
+// NewFileSystem creates a new file system. Options of type Option
+// configure the file system, all others are used for every call.
 func NewFileSystem(client pb.RawFileSystemClient, opts ...grpc.CallOption) *fileSystem {
-	return &fileSystem{
-		RawFileSystem: fuse.NewDefaultRawFileSystem(),
-		client:        client,
-		opts:          opts,
+	fs := &fileSystem{
+		RawFileSystem:    fuse.NewDefaultRawFileSystem(),
+		client:           client,
+		msgSizeThreshold: msgSizeThreshold,
+		unavailableErrno: syscall.ENOTCONN,
+		defaultTimeout:   defaultTimeout,
+		features:         utils.Features,
+		timeouts:         make(map[string]time.Duration, len(defaultTimeouts)),
+		forgets: forgetBatch{
+			delay: defaultForgetDelay,
+			size:  defaultForgetBatchSize,
+		},
+	}
+	for method, d := range defaultTimeouts {
+		fs.timeouts[method] = d
+	}
+	for _, opt := range opts {
+		if o, ok := opt.(Option); ok {
+			o.apply(fs)
+			continue
+		}
+		fs.opts = append(fs.opts, opt)
 	}
+	if fs.clientID == "" {
+		fs.clientID = utils.NewClientID()
+	}
+	fs.ctx, fs.cancel = context.WithCancel(context.Background())
+	fs.ctx = metadata.AppendToOutgoingContext(fs.ctx, utils.ClientIDKey, fs.clientID)
+	if fs.export != "" {
+		fs.ctx = metadata.AppendToOutgoingContext(fs.ctx, utils.ExportKey, fs.export)
+	}
+	return fs
 }
 
 func (fs *fileSystem) String() string {
-	res, err := fs.client.String(context.TODO(), &pb.StringRequest{}, fs.opts...)
+	ctx, done := fs.newContext(nil, "String")
+	defer done()
+	res, err := fs.client.String(ctx, &pb.StringRequest{}, fs.opts...)
 	if err != nil {
 		log.Errorf("String: %v", err)
 		return defaultName
 	}
 	return res.Value
 }
+
+// Close sends the writes and Forgets still pending and stops watching
+// the server, it is called once the file system is unmounted.
+func (fs *fileSystem) Close() {
+	fs.writeBack.flushAll()
+	fs.flushForgets()
+	fs.cancel()
+}
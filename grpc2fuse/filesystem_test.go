@@ -0,0 +1,56 @@
+package grpc2fuse_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+func TestClientID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+
+	clientID := func(ctx context.Context) string {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		require.Len(t, md.Get(utils.ClientIDKey), 1)
+		return md.Get(utils.ClientIDKey)[0]
+	}
+
+	var ids []string
+	client.EXPECT().String(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *pb.StringRequest, opts ...grpc.CallOption) (*pb.StringResponse, error) {
+			ids = append(ids, clientID(ctx))
+			return &pb.StringResponse{Value: "test"}, nil
+		}).Times(3)
+
+	require.Equal(t, "test", grpc2fuse.NewFileSystem(client, grpc2fuse.WithClientID("foo")).String())
+	require.Equal(t, "test", grpc2fuse.NewFileSystem(client).String())
+	require.Equal(t, "test", grpc2fuse.NewFileSystem(client).String())
+
+	require.Equal(t, "foo", ids[0])
+	require.NotEmpty(t, ids[1])
+	require.NotEqual(t, ids[1], ids[2])
+}
+
+func TestExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+
+	client.EXPECT().String(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *pb.StringRequest, opts ...grpc.CallOption) (*pb.StringResponse, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			require.Equal(t, []string{"home"}, md.Get(utils.ExportKey))
+			return &pb.StringResponse{Value: "test"}, nil
+		})
+
+	require.Equal(t, "test", grpc2fuse.NewFileSystem(client, grpc2fuse.WithExport("home")).String())
+}
@@ -23,7 +23,12 @@ import (
 )
 
 func (fs *fileSystem) Flush(cancel <-chan struct{}, input *fuse.FlushIn) (code fuse.Status) {
-	ctx := newContext(cancel)
+	// The server sees the Flush even if a deferred write failed, and the
+	// write error is reported ahead of its status.
+	wst := fs.writeBack.flush(input.Fh)
+
+	ctx, done := fs.newContext(cancel, "Flush")
+	defer done()
 
 	res, err := fs.client.Flush(ctx, &pb.FlushRequest{
 		Header:    toPbHeader(&input.InHeader),
@@ -33,7 +38,10 @@ func (fs *fileSystem) Flush(cancel <-chan struct{}, input *fuse.FlushIn) (code f
 		LockOwner: input.LockOwner,
 	}, fs.opts...)
 
-	if st := dealGrpcError("Flush", err); st != fuse.OK {
+	if wst != fuse.OK {
+		return wst
+	}
+	if st := fs.dealGrpcError("Flush", err); st != fuse.OK {
 		return st
 	}
 
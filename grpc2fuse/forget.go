@@ -17,12 +17,132 @@
 package grpc2fuse
 
 import (
-	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/chiyutianyi/grpcfuse/pb"
 )
 
+const (
+	defaultForgetDelay     = 10 * time.Millisecond
+	defaultForgetBatchSize = 1024
+)
+
+// forgetBatch collects the Forgets of the kernel, which come in
+// thousands when it drops its dentry cache, to send them to the server
+// in one BatchForget.
+type forgetBatch struct {
+	mu sync.Mutex
+
+	// delay is how long a Forget waits for others, 0 disables
+	// batching.
+	delay time.Duration
+	// size is the number of nodes sent right away.
+	size int
+
+	pending map[uint64]uint64
+	timer   *time.Timer
+}
+
+// WithForgetBatch waits up to delay to send Forgets together, or until
+// size nodes are forgotten. A delay of 0 sends every Forget on its own.
+func WithForgetBatch(delay time.Duration, size int) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		fs.forgets.delay = delay
+		if size > 0 {
+			fs.forgets.size = size
+		}
+	}}
+}
+
 func (fs *fileSystem) Forget(nodeid, nlookup uint64) {
-	_, err := fs.client.Forget(context.TODO(), &pb.ForgetRequest{Nodeid: nodeid, Nlookup: nlookup}, fs.opts...)
-	dealGrpcError("Forget", err)
+	if nlookup = fs.cache.forget(nodeid, nlookup); nlookup == 0 {
+		return
+	}
+	fs.queueForget(nodeid, nlookup)
+}
+
+// queueForget sends a Forget of nlookup to the server, bypassing the
+// cache.
+func (fs *fileSystem) queueForget(nodeid, nlookup uint64) {
+	if fs.forgets.delay <= 0 || atomic.LoadInt32(&fs.noBatchForget) != 0 {
+		fs.forget(nodeid, nlookup)
+		return
+	}
+
+	b := &fs.forgets
+	b.mu.Lock()
+	if b.pending == nil {
+		b.pending = make(map[uint64]uint64)
+	}
+	b.pending[nodeid] += nlookup
+	if len(b.pending) < b.size {
+		if b.timer == nil {
+			b.timer = time.AfterFunc(b.delay, fs.flushForgets)
+		}
+		b.mu.Unlock()
+		return
+	}
+	pending := b.take()
+	b.mu.Unlock()
+
+	fs.sendForgets(pending)
+}
+
+// take returns the pending Forgets and resets the batch, the caller
+// holds mu.
+func (b *forgetBatch) take() map[uint64]uint64 {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	pending := b.pending
+	b.pending = nil
+	return pending
+}
+
+// flushForgets sends the pending Forgets.
+func (fs *fileSystem) flushForgets() {
+	fs.forgets.mu.Lock()
+	pending := fs.forgets.take()
+	fs.forgets.mu.Unlock()
+
+	fs.sendForgets(pending)
+}
+
+// sendForgets sends pending in one BatchForget. Servers without
+// BatchForget get single Forgets from then on.
+func (fs *fileSystem) sendForgets(pending map[uint64]uint64) {
+	if len(pending) == 0 {
+		return
+	}
+
+	req := &pb.BatchForgetRequest{Forgets: make([]*pb.ForgetRequest, 0, len(pending))}
+	for nodeid, nlookup := range pending {
+		req.Forgets = append(req.Forgets, &pb.ForgetRequest{Nodeid: nodeid, Nlookup: nlookup})
+	}
+	ctx, done := fs.newContext(nil, "BatchForget")
+	defer done()
+	_, err := fs.client.BatchForget(ctx, req, fs.opts...)
+	if status.Code(err) == codes.Unimplemented {
+		log.Warnf("BatchForget unimplemented, falling back to Forget")
+		atomic.StoreInt32(&fs.noBatchForget, 1)
+		for _, forget := range req.Forgets {
+			fs.forget(forget.Nodeid, forget.Nlookup)
+		}
+		return
+	}
+	fs.dealGrpcError("BatchForget", err)
+}
+
+func (fs *fileSystem) forget(nodeid, nlookup uint64) {
+	ctx, done := fs.newContext(nil, "Forget")
+	defer done()
+	_, err := fs.client.Forget(ctx, &pb.ForgetRequest{Nodeid: nodeid, Nlookup: nlookup}, fs.opts...)
+	fs.dealGrpcError("Forget", err)
 }
@@ -0,0 +1,70 @@
+package grpc2fuse_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+func TestBatchForget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+	fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithForgetBatch(time.Hour, 3))
+
+	var batches []map[uint64]uint64
+	client.EXPECT().BatchForget(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *pb.BatchForgetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+			batch := make(map[uint64]uint64)
+			for _, forget := range in.Forgets {
+				batch[forget.Nodeid] = forget.Nlookup
+			}
+			batches = append(batches, batch)
+			return &emptypb.Empty{}, nil
+		}).Times(2)
+
+	// the batch is sent once it holds 3 nodes
+	fs.Forget(2, 1)
+	fs.Forget(2, 1)
+	fs.Forget(3, 1)
+	require.Empty(t, batches)
+	fs.Forget(4, 5)
+	require.Equal(t, []map[uint64]uint64{{2: 2, 3: 1, 4: 5}}, batches)
+
+	// Close flushes the rest
+	fs.Forget(5, 1)
+	fs.Close()
+	require.Len(t, batches, 2)
+	require.Equal(t, map[uint64]uint64{5: 1}, batches[1])
+}
+
+func TestBatchForgetFallback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+	fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithForgetBatch(time.Millisecond, 0))
+
+	done := make(chan struct{})
+	client.EXPECT().BatchForget(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unimplemented, ""))
+	client.EXPECT().Forget(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *pb.ForgetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+			require.Equal(t, uint64(2), in.Nodeid)
+			close(done)
+			return &emptypb.Empty{}, nil
+		})
+	// servers without BatchForget get single Forgets from then on
+	client.EXPECT().Forget(gomock.Any(), gomock.Any()).Return(&emptypb.Empty{}, nil)
+
+	fs.Forget(2, 1)
+	<-done
+	fs.Forget(3, 1)
+}
@@ -23,7 +23,12 @@ import (
 )
 
 func (fs *fileSystem) Fsync(cancel <-chan struct{}, input *fuse.FsyncIn) (code fuse.Status) {
-	ctx := newContext(cancel)
+	// The server sees the Fsync even if a deferred write failed, and the
+	// write error is reported ahead of its status.
+	wst := fs.writeBack.flush(input.Fh)
+
+	ctx, done := fs.newContext(cancel, "Fsync")
+	defer done()
 
 	res, err := fs.client.Fsync(ctx, &pb.FsyncRequest{
 		Header:     toPbHeader(&input.InHeader),
@@ -32,7 +37,10 @@ func (fs *fileSystem) Fsync(cancel <-chan struct{}, input *fuse.FsyncIn) (code f
 		Padding:    input.Padding,
 	}, fs.opts...)
 
-	if st := dealGrpcError("Fsync", err); st != fuse.OK {
+	if wst != fuse.OK {
+		return wst
+	}
+	if st := fs.dealGrpcError("Fsync", err); st != fuse.OK {
 		return st
 	}
 
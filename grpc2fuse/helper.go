@@ -17,12 +17,17 @@
 package grpc2fuse
 
 import (
+	"syscall"
+
 	"github.com/hanwen/go-fuse/v2/fuse"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
 )
 
 func toPbHeader(header *fuse.InHeader) *pb.InHeader {
@@ -41,6 +46,39 @@ func toPbHeader(header *fuse.InHeader) *pb.InHeader {
 	}
 }
 
+func toPbKernelSettings(in *fuse.InitIn) *pb.KernelSettings {
+	return &pb.KernelSettings{
+		Major:        in.Major,
+		Minor:        in.Minor,
+		MaxReadahead: in.MaxReadAhead,
+		Flags:        in.Flags,
+	}
+}
+
+func toPbMountOptions(in *fuse.MountOptions) *pb.MountOptions {
+	if in == nil {
+		return nil
+	}
+	return &pb.MountOptions{
+		AllowOther:               in.AllowOther,
+		Options:                  in.Options,
+		MaxBackground:            int32(in.MaxBackground),
+		MaxWrite:                 int32(in.MaxWrite),
+		MaxReadAhead:             int32(in.MaxReadAhead),
+		IgnoreSecurityLabels:     in.IgnoreSecurityLabels,
+		RememberInodes:           in.RememberInodes,
+		FsName:                   in.FsName,
+		Name:                     in.Name,
+		SingleThreaded:           in.SingleThreaded,
+		DisableXattrs:            in.DisableXAttrs,
+		EnableLocks:              in.EnableLocks,
+		ExplicitDataCacheControl: in.ExplicitDataCacheControl,
+		SyncRead:                 in.SyncRead,
+		DirectMount:              in.DirectMount,
+		EnableAcl:                in.EnableAcl,
+	}
+}
+
 func toFuseAttr(out *fuse.Attr, in *pb.Attr) {
 	out.Ino = in.Ino
 	out.Size = in.Size
@@ -87,16 +125,80 @@ func toFuseOpenOut(out *fuse.OpenOut, in *pb.OpenOut) {
 	out.Padding = in.Padding
 }
 
-func dealGrpcError(method string, err error) fuse.Status {
+// codeErrnos maps the codes of gRPC errors to the errno returned to the
+// kernel. Codes not listed become EIO.
+var codeErrnos = map[codes.Code]syscall.Errno{
+	codes.DeadlineExceeded:  syscall.ETIMEDOUT,
+	codes.NotFound:          syscall.ENOENT,
+	codes.AlreadyExists:     syscall.EEXIST,
+	codes.InvalidArgument:   syscall.EINVAL,
+	codes.OutOfRange:        syscall.EINVAL,
+	codes.PermissionDenied:  syscall.EACCES,
+	codes.Unauthenticated:   syscall.EPERM,
+	codes.ResourceExhausted: syscall.ENOSPC,
+}
+
+// defaultMaxMsgSize is the default limit of gRPC servers on the size of
+// the messages they receive.
+const defaultMaxMsgSize = 4 << 20
+
+// maxSendMsgSize returns the size of the largest message the client
+// sends: the limit set with grpc.MaxCallSendMsgSize, or the limit of
+// servers by default.
+func (fs *fileSystem) maxSendMsgSize() int {
+	for _, opt := range fs.opts {
+		if o, ok := opt.(grpc.MaxSendMsgSizeCallOption); ok {
+			return o.MaxSendMsgSize
+		}
+	}
+	return defaultMaxMsgSize
+}
+
+// sizeError attaches EFBIG to the ResourceExhausted error of a request
+// larger than maxSendMsgSize, which gRPC rejected for its size rather
+// than for a lack of space. Other errors are returned as they are.
+func (fs *fileSystem) sizeError(err error, req proto.Message) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.ResourceExhausted || proto.Size(req) <= fs.maxSendMsgSize() {
+		return err
+	}
+	if _, ok := utils.ErrnoFromStatus(st); ok {
+		return err
+	}
+	return utils.ErrnoError(codes.ResourceExhausted, syscall.EFBIG, st.Message())
+}
+
+// dealGrpcError converts the error of a request to the status returned
+// to the kernel. An errno attached by the server with
+// utils.ErrnoError wins over the one mapped from the code.
+func (fs *fileSystem) dealGrpcError(method string, err error) fuse.Status {
 	if err == nil {
 		return fuse.OK
 	}
-	if st, ok := status.FromError(err); ok {
-		if st.Code() == codes.Unimplemented {
-			log.Warnf("%s unimplemented", method)
-			return fuse.ENOSYS
-		}
+	st, ok := status.FromError(err)
+	if !ok {
+		log.Errorf("%s: %v", method, err)
+		return fuse.EIO
+	}
+	if errno, ok := utils.ErrnoFromStatus(st); ok {
+		log.Errorf("%s: %v", method, err)
+		return fuse.Status(errno)
+	}
+
+	switch st.Code() {
+	case codes.Unimplemented:
+		log.Warnf("%s unimplemented", method)
+		return fuse.ENOSYS
+	case codes.Canceled:
+		log.Debugf("%s: %v", method, err)
+		return fuse.EINTR
+	case codes.Unavailable:
+		log.Errorf("%s: server unavailable: %v", method, err)
+		return fuse.Status(fs.unavailableErrno)
 	}
 	log.Errorf("%s: %v", method, err)
+	if errno, ok := codeErrnos[st.Code()]; ok {
+		return fuse.Status(errno)
+	}
 	return fuse.EIO
 }
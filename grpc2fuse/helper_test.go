@@ -0,0 +1,65 @@
+package grpc2fuse_test
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+func TestErrno(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+
+	testcases := []struct {
+		err   error
+		errno syscall.Errno
+	}{
+		{status.Error(codes.DeadlineExceeded, ""), syscall.ETIMEDOUT},
+		{status.Error(codes.Unavailable, ""), syscall.ENOTCONN},
+		{status.Error(codes.Canceled, ""), syscall.EINTR},
+		{status.Error(codes.ResourceExhausted, "quota exceeded"), syscall.ENOSPC},
+		// the text of gRPC errors is not relied upon
+		{status.Error(codes.ResourceExhausted, "grpc: received message larger than max (5 vs. 4)"), syscall.ENOSPC},
+		{status.Error(codes.PermissionDenied, ""), syscall.EACCES},
+		{status.Error(codes.Unauthenticated, ""), syscall.EPERM},
+		{status.Error(codes.Internal, ""), syscall.EIO},
+		// the errno of the server wins
+		{utils.ErrnoError(codes.PermissionDenied, syscall.EROFS, ""), syscall.EROFS},
+	}
+
+	fs := grpc2fuse.NewFileSystem(client)
+	var out fuse.AttrOut
+	for _, testcase := range testcases {
+		client.EXPECT().GetAttr(gomock.Any(), gomock.Any()).Return(nil, testcase.err)
+		require.Equal(t, fuse.Status(testcase.errno), fs.GetAttr(nil, &fuse.GetAttrIn{InHeader: TestInHeader}, &out), testcase.err)
+	}
+
+	fs = grpc2fuse.NewFileSystem(client, grpc2fuse.WithUnavailableErrno(syscall.EAGAIN))
+	client.EXPECT().GetAttr(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, ""))
+	require.Equal(t, fuse.Status(syscall.EAGAIN), fs.GetAttr(nil, &fuse.GetAttrIn{InHeader: TestInHeader}, &out))
+}
+
+func TestWriteTooLarge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+
+	// requests larger than the send limit fail with EFBIG, smaller
+	// ones with ENOSPC
+	fs := grpc2fuse.NewFileSystem(client, grpc.MaxCallSendMsgSize(64))
+	client.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.ResourceExhausted, "")).Times(2)
+	in := &fuse.WriteIn{InHeader: TestInHeader, Fh: 1}
+	_, st := fs.Write(nil, in, make([]byte, 128))
+	require.Equal(t, fuse.Status(syscall.EFBIG), st)
+	_, st = fs.Write(nil, in, make([]byte, 8))
+	require.Equal(t, fuse.Status(syscall.ENOSPC), st)
+}
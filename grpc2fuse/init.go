@@ -0,0 +1,132 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc2fuse
+
+import (
+	"context"
+
+	"fmt"
+	"sync/atomic"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/compress"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+// Handshake exchanges the protocol version and the features with the
+// server, and fails if the server speaks an incompatible protocol.
+// Callers call it before mounting, which refuses an incompatible server
+// with a clear error, and lets Init pass on the kernel settings. Once it
+// succeeded, further calls return nil right away.
+//
+// Servers without Init are assumed to support all features, the file
+// system then falls back whenever a request is unimplemented.
+func (fs *fileSystem) Handshake() error {
+	fs.handshakeMu.Lock()
+	defer fs.handshakeMu.Unlock()
+	if fs.handshaked {
+		return nil
+	}
+
+	ctx, done := fs.newRetryContext(nil, "Init")
+	defer done()
+
+	var res *pb.InitResponse
+	err := fs.retry(ctx, "Init", func(ctx context.Context, opts []grpc.CallOption) (err error) {
+		res, err = fs.client.Init(ctx, &pb.InitRequest{
+			Major:       utils.ProtocolMajor,
+			Minor:       utils.ProtocolMinor,
+			Features:    utils.Features,
+			Compressors: compress.Names,
+		}, opts...)
+		return err
+	})
+
+	switch status.Code(err) {
+	case codes.OK:
+		if res.Major != utils.ProtocolMajor {
+			return fmt.Errorf("server protocol %d.%d is incompatible with client protocol %d.%d",
+				res.Major, res.Minor, utils.ProtocolMajor, utils.ProtocolMinor)
+		}
+	case codes.Unimplemented:
+		log.Infof("Init unimplemented, assuming all features")
+		res = &pb.InitResponse{Features: utils.Features}
+	case codes.FailedPrecondition:
+		return fmt.Errorf("server refused the client: %s", status.Convert(err).Message())
+	default:
+		return fmt.Errorf("init: %v", err)
+	}
+
+	fs.serverMajor = res.Major
+	features := res.Features & utils.Features
+	log.Debugf("server protocol %d.%d features %#x", res.Major, res.Minor, features)
+	atomic.StoreUint64(&fs.features, features)
+	if !fs.hasFeature(pb.Feature_FEATURE_WRITE_STREAM) {
+		atomic.StoreInt32(&fs.noWriteStream, 1)
+	}
+	if !fs.hasFeature(pb.Feature_FEATURE_BATCH_FORGET) {
+		atomic.StoreInt32(&fs.noBatchForget, 1)
+	}
+	fs.selectCompressor(res)
+	fs.selectChecksum(res)
+	fs.handshaked = true
+	return nil
+}
+
+// hasFeature reports whether the server supports f. It is true for all
+// features until the handshake is done.
+func (fs *fileSystem) hasFeature(f pb.Feature) bool {
+	return utils.HasFeature(atomic.LoadUint64(&fs.features), f)
+}
+
+// WithMountOptions passes the options the file system is mounted with
+// to the server once it is mounted.
+func WithMountOptions(opts fuse.MountOptions) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		fs.mountOptions = &opts
+	}}
+}
+
+// initKernel tells the server the settings of the kernel and the mount
+// options.
+func (fs *fileSystem) initKernel(kernel *fuse.InitIn) {
+	if fs.serverMajor == 0 {
+		// the server does not know Init
+		return
+	}
+
+	ctx, done := fs.newRetryContext(nil, "Init")
+	defer done()
+
+	err := fs.retry(ctx, "Init", func(ctx context.Context, opts []grpc.CallOption) error {
+		_, err := fs.client.Init(ctx, &pb.InitRequest{
+			Major:        utils.ProtocolMajor,
+			Minor:        utils.ProtocolMinor,
+			Features:     utils.Features,
+			Kernel:       toPbKernelSettings(kernel),
+			MountOptions: toPbMountOptions(fs.mountOptions),
+		}, opts...)
+		return err
+	})
+	fs.dealGrpcError("Init", err)
+}
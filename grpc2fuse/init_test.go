@@ -0,0 +1,80 @@
+package grpc2fuse_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+func TestHandshake(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+
+	// servers without locks and write streams
+	features := utils.Features &^ uint64(pb.Feature_FEATURE_LOCKS|pb.Feature_FEATURE_WRITE_STREAM)
+	client.EXPECT().Init(gomock.Any(), gomock.Any()).Return(&pb.InitResponse{
+		Major:    utils.ProtocolMajor,
+		Features: features,
+	}, nil)
+
+	fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithMsgSizeThreshold(1))
+	require.NoError(t, fs.Handshake())
+	// the result is kept
+	require.NoError(t, fs.Handshake())
+
+	require.Equal(t, fuse.ENOSYS, fs.SetLk(nil, &fuse.LkIn{InHeader: TestInHeader}))
+
+	client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(&pb.WriteResponse{
+		Status:  &pb.Status{},
+		Written: 2,
+	}, nil)
+	written, st := fs.Write(nil, &fuse.WriteIn{InHeader: TestInHeader, Size: 2}, []byte("ab"))
+	require.Equal(t, fuse.OK, st)
+	require.Equal(t, uint32(2), written)
+
+	// servers of another major version
+	client.EXPECT().Init(gomock.Any(), gomock.Any()).Return(&pb.InitResponse{Major: utils.ProtocolMajor + 1}, nil)
+	require.Error(t, grpc2fuse.NewFileSystem(client).Handshake())
+
+	client.EXPECT().Init(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.FailedPrecondition, "too old"))
+	require.Error(t, grpc2fuse.NewFileSystem(client).Handshake())
+
+	// servers before Init
+	client.EXPECT().Init(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unimplemented, ""))
+	require.NoError(t, grpc2fuse.NewFileSystem(client).Handshake())
+}
+
+func TestInitKernel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+
+	fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithMountOptions(fuse.MountOptions{MaxWrite: 1 << 17, EnableLocks: true}))
+	// without a handshake Init does not call the server
+	fs.Init(&fuse.Server{})
+
+	client.EXPECT().Init(gomock.Any(), gomock.Any()).Return(&pb.InitResponse{
+		Major:    utils.ProtocolMajor,
+		Features: utils.Features &^ uint64(pb.Feature_FEATURE_NOTIFY),
+	}, nil)
+	client.EXPECT().Init(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *pb.InitRequest, opts ...grpc.CallOption) (*pb.InitResponse, error) {
+			require.NotNil(t, in.Kernel)
+			require.Equal(t, int32(1<<17), in.MountOptions.GetMaxWrite())
+			require.True(t, in.MountOptions.GetEnableLocks())
+			return &pb.InitResponse{Major: utils.ProtocolMajor}, nil
+		})
+
+	require.NoError(t, fs.Handshake())
+	fs.Init(&fuse.Server{})
+}
@@ -23,7 +23,8 @@ import (
 )
 
 func (fs *fileSystem) Link(cancel <-chan struct{}, input *fuse.LinkIn, filename string, out *fuse.EntryOut) (code fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "Link")
+	defer done()
 
 	res, err := fs.client.Link(ctx, &pb.LinkRequest{
 		Header:    toPbHeader(&input.InHeader),
@@ -31,7 +32,7 @@ func (fs *fileSystem) Link(cancel <-chan struct{}, input *fuse.LinkIn, filename
 		Filename:  filename,
 	}, fs.opts...)
 
-	if st := dealGrpcError("Link", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Link", err); st != fuse.OK {
 		return st
 	}
 	if res.Status.GetCode() != 0 {
@@ -39,11 +40,14 @@ func (fs *fileSystem) Link(cancel <-chan struct{}, input *fuse.LinkIn, filename
 	}
 
 	toFuseEntryOut(out, res.EntryOut)
+	fs.cache.dropAttr(input.NodeId, input.Oldnodeid)
+	fs.cache.setEntry(input.NodeId, filename, out)
 	return fuse.OK
 }
 
 func (fs *fileSystem) Symlink(cancel <-chan struct{}, header *fuse.InHeader, pointedTo string, linkName string, out *fuse.EntryOut) (code fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "Symlink")
+	defer done()
 
 	res, err := fs.client.Symlink(ctx, &pb.SymlinkRequest{
 		Header:    toPbHeader(header),
@@ -51,7 +55,7 @@ func (fs *fileSystem) Symlink(cancel <-chan struct{}, header *fuse.InHeader, poi
 		LinkName:  linkName,
 	}, fs.opts...)
 
-	if st := dealGrpcError("Symlink", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Symlink", err); st != fuse.OK {
 		return st
 	}
 	if res.Status.GetCode() != 0 {
@@ -59,17 +63,20 @@ func (fs *fileSystem) Symlink(cancel <-chan struct{}, header *fuse.InHeader, poi
 	}
 
 	toFuseEntryOut(out, res.EntryOut)
+	fs.cache.dropAttr(header.NodeId)
+	fs.cache.setEntry(header.NodeId, linkName, out)
 	return fuse.OK
 }
 
 func (fs *fileSystem) Readlink(cancel <-chan struct{}, header *fuse.InHeader) (out []byte, code fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "Readlink")
+	defer done()
 
 	res, err := fs.client.Readlink(ctx, &pb.ReadlinkRequest{
 		Header: toPbHeader(header),
 	}, fs.opts...)
 
-	if st := dealGrpcError("Readlink", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Readlink", err); st != fuse.OK {
 		return nil, st
 	}
 
@@ -23,7 +23,11 @@ import (
 )
 
 func (fs *fileSystem) GetLk(cancel <-chan struct{}, input *fuse.LkIn, out *fuse.LkOut) (code fuse.Status) {
-	ctx := newContext(cancel)
+	if !fs.hasFeature(pb.Feature_FEATURE_LOCKS) {
+		return fuse.ENOSYS
+	}
+	ctx, done := fs.newContext(cancel, "GetLk")
+	defer done()
 
 	res, err := fs.client.GetLk(ctx, &pb.LkRequest{
 		Header: toPbHeader(&input.InHeader),
@@ -39,7 +43,7 @@ func (fs *fileSystem) GetLk(cancel <-chan struct{}, input *fuse.LkIn, out *fuse.
 		Padding: input.Padding,
 	}, fs.opts...)
 
-	if st := dealGrpcError("GetLk", err); st != fuse.OK {
+	if st := fs.dealGrpcError("GetLk", err); st != fuse.OK {
 		return st
 	}
 	if res.Status.GetCode() != 0 {
@@ -53,7 +57,11 @@ func (fs *fileSystem) GetLk(cancel <-chan struct{}, input *fuse.LkIn, out *fuse.
 }
 
 func (fs *fileSystem) SetLk(cancel <-chan struct{}, input *fuse.LkIn) (code fuse.Status) {
-	ctx := newContext(cancel)
+	if !fs.hasFeature(pb.Feature_FEATURE_LOCKS) {
+		return fuse.ENOSYS
+	}
+	ctx, done := fs.newContext(cancel, "SetLk")
+	defer done()
 
 	res, err := fs.client.SetLk(ctx, &pb.LkRequest{
 		Header: toPbHeader(&input.InHeader),
@@ -69,7 +77,7 @@ func (fs *fileSystem) SetLk(cancel <-chan struct{}, input *fuse.LkIn) (code fuse
 		Padding: input.Padding,
 	}, fs.opts...)
 
-	if st := dealGrpcError("SetLk", err); st != fuse.OK {
+	if st := fs.dealGrpcError("SetLk", err); st != fuse.OK {
 		return st
 	}
 
@@ -77,7 +85,11 @@ func (fs *fileSystem) SetLk(cancel <-chan struct{}, input *fuse.LkIn) (code fuse
 }
 
 func (fs *fileSystem) SetLkw(cancel <-chan struct{}, input *fuse.LkIn) (code fuse.Status) {
-	ctx := newContext(cancel)
+	if !fs.hasFeature(pb.Feature_FEATURE_LOCKS) {
+		return fuse.ENOSYS
+	}
+	ctx, done := fs.newContext(cancel, "SetLkw")
+	defer done()
 
 	res, err := fs.client.SetLkw(ctx, &pb.LkRequest{
 		Header: toPbHeader(&input.InHeader),
@@ -93,7 +105,7 @@ func (fs *fileSystem) SetLkw(cancel <-chan struct{}, input *fuse.LkIn) (code fus
 		Padding: input.Padding,
 	}, fs.opts...)
 
-	if st := dealGrpcError("SetLkw", err); st != fuse.OK {
+	if st := fs.dealGrpcError("SetLkw", err); st != fuse.OK {
 		return st
 	}
 
@@ -17,20 +17,32 @@
 package grpc2fuse
 
 import (
+	"context"
+
 	"github.com/chiyutianyi/grpcfuse/pb"
 
 	"github.com/hanwen/go-fuse/v2/fuse"
+	"google.golang.org/grpc"
 )
 
 func (fs *fileSystem) Lookup(cancel <-chan struct{}, header *fuse.InHeader, name string, out *fuse.EntryOut) (status fuse.Status) {
-	ctx := newContext(cancel)
+	if fs.cache.lookup(header.NodeId, name, out) {
+		return fuse.OK
+	}
+
+	ctx, done := fs.newRetryContext(cancel, "Lookup")
+	defer done()
 
-	res, err := fs.client.Lookup(ctx, &pb.LookupRequest{
-		Header: toPbHeader(header),
-		Name:   name,
-	}, fs.opts...)
+	var res *pb.LookupResponse
+	err := fs.retry(ctx, "Lookup", func(ctx context.Context, opts []grpc.CallOption) (err error) {
+		res, err = fs.client.Lookup(ctx, &pb.LookupRequest{
+			Header: toPbHeader(header),
+			Name:   name,
+		}, opts...)
+		return err
+	})
 
-	if st := dealGrpcError("Lookup", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Lookup", err); st != fuse.OK {
 		return st
 	}
 
@@ -38,5 +50,6 @@ func (fs *fileSystem) Lookup(cancel <-chan struct{}, header *fuse.InHeader, name
 		return fuse.Status(res.Status.GetCode())
 	}
 	toFuseEntryOut(out, res.EntryOut)
+	fs.cache.setEntry(header.NodeId, name, out)
 	return fuse.OK
 }
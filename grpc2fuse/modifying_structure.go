@@ -23,7 +23,8 @@ import (
 )
 
 func (fs *fileSystem) Mkdir(cancel <-chan struct{}, input *fuse.MkdirIn, name string, out *fuse.EntryOut) (code fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "Mkdir")
+	defer done()
 
 	res, err := fs.client.Mkdir(ctx, &pb.MkdirRequest{
 		Header: toPbHeader(&input.InHeader),
@@ -32,7 +33,7 @@ func (fs *fileSystem) Mkdir(cancel <-chan struct{}, input *fuse.MkdirIn, name st
 		Umask:  input.Umask,
 	}, fs.opts...)
 
-	if st := dealGrpcError("Mkdir", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Mkdir", err); st != fuse.OK {
 		return st
 	}
 	if res.Status.GetCode() != 0 {
@@ -40,39 +41,46 @@ func (fs *fileSystem) Mkdir(cancel <-chan struct{}, input *fuse.MkdirIn, name st
 	}
 
 	toFuseEntryOut(out, res.EntryOut)
+	fs.cache.dropAttr(input.NodeId)
+	fs.cache.setEntry(input.NodeId, name, out)
 	return fuse.OK
 }
 
 func (fs *fileSystem) Unlink(cancel <-chan struct{}, header *fuse.InHeader, name string) (code fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "Unlink")
+	defer done()
 
 	res, err := fs.client.Unlink(ctx, &pb.UnlinkRequest{
 		Header: toPbHeader(header),
 		Name:   name,
 	}, fs.opts...)
+	fs.cache.dropEntry(header.NodeId, name)
 
-	if st := dealGrpcError("Unlink", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Unlink", err); st != fuse.OK {
 		return st
 	}
 	return fuse.Status(res.Status.GetCode())
 }
 
 func (fs *fileSystem) Rmdir(cancel <-chan struct{}, header *fuse.InHeader, name string) (code fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "Rmdir")
+	defer done()
 
 	res, err := fs.client.Rmdir(ctx, &pb.RmdirRequest{
 		Header: toPbHeader(header),
 		Name:   name,
 	}, fs.opts...)
+	fs.cache.dropEntry(header.NodeId, name)
 
-	if st := dealGrpcError("Rmdir", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Rmdir", err); st != fuse.OK {
 		return st
 	}
 	return fuse.Status(res.Status.GetCode())
 }
 
 func (fs *fileSystem) Rename(cancel <-chan struct{}, input *fuse.RenameIn, oldName string, newName string) (code fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "Rename")
+	defer done()
 
 	res, err := fs.client.Rename(ctx, &pb.RenameRequest{
 		Header:  toPbHeader(&input.InHeader),
@@ -82,8 +90,10 @@ func (fs *fileSystem) Rename(cancel <-chan struct{}, input *fuse.RenameIn, oldNa
 		Flags:   input.Flags,
 		Padding: input.Padding,
 	}, fs.opts...)
+	fs.cache.dropEntry(input.NodeId, oldName)
+	fs.cache.dropEntry(input.Newdir, newName)
 
-	if st := dealGrpcError("Rename", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Rename", err); st != fuse.OK {
 		return st
 	}
 	return fuse.Status(res.Status.GetCode())
@@ -23,7 +23,8 @@ import (
 )
 
 func (fs *fileSystem) Mknod(cancel <-chan struct{}, input *fuse.MknodIn, name string, out *fuse.EntryOut) (code fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "Mknod")
+	defer done()
 
 	res, err := fs.client.Mknod(ctx, &pb.MknodRequest{
 		Header: toPbHeader(&input.InHeader),
@@ -32,7 +33,7 @@ func (fs *fileSystem) Mknod(cancel <-chan struct{}, input *fuse.MknodIn, name st
 		Rdev:   input.Rdev,
 	}, fs.opts...)
 
-	if st := dealGrpcError("Mknod", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Mknod", err); st != fuse.OK {
 		return st
 	}
 	if res.Status.GetCode() != 0 {
@@ -40,5 +41,7 @@ func (fs *fileSystem) Mknod(cancel <-chan struct{}, input *fuse.MknodIn, name st
 	}
 
 	toFuseEntryOut(out, res.EntryOut)
+	fs.cache.dropAttr(input.NodeId)
+	fs.cache.setEntry(input.NodeId, name, out)
 	return fuse.OK
 }
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc2fuse
+
+import (
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+const (
+	notifyInitialBackoff = 100 * time.Millisecond
+	notifyMaxBackoff     = 10 * time.Second
+)
+
+// KernelNotifier is the part of *fuse.Server invalidating the caches of
+// the kernel.
+type KernelNotifier interface {
+	InodeNotify(node uint64, off int64, length int64) fuse.Status
+	EntryNotify(parent uint64, name string) fuse.Status
+}
+
+// Init is called by the fuse.Server mounting the file system. Once the
+// handshake is done, it passes the settings of the kernel to the server
+// and starts forwarding the invalidations of the server to the kernel.
+// Init runs within fuse.NewServer, so it does not do the handshake
+// itself: without one before mounting, the file system is served
+// without both.
+func (fs *fileSystem) Init(server *fuse.Server) {
+	fs.RawFileSystem.Init(server)
+
+	fs.handshakeMu.Lock()
+	handshaked := fs.handshaked
+	fs.handshakeMu.Unlock()
+	if !handshaked {
+		log.Warnf("Init: mounted without a handshake, the server gets no kernel settings and sends no invalidations")
+		return
+	}
+	fs.initKernel(server.KernelSettings())
+	if fs.hasFeature(pb.Feature_FEATURE_NOTIFY) {
+		go fs.Watch(server)
+	}
+}
+
+// Watch subscribes to the changes other clients make to the file system
+// and invalidates the affected entries of the attribute cache and of
+// the kernel. It resubscribes whenever the stream breaks, and only returns
+// if the server does not support Notify. Changes made while the stream
+// is broken are lost, the kernel then relies on its timeouts.
+// Watch returns as well once the file system is closed.
+func (fs *fileSystem) Watch(kernel KernelNotifier) {
+	backoff := notifyInitialBackoff
+	for {
+		stream, err := fs.client.Notify(fs.ctx, &pb.NotifyRequest{}, append(fs.opts, grpc.WaitForReady(true))...)
+		if err == nil {
+			// the server sends its header once subscribed
+			if _, err = stream.Header(); err == nil {
+				backoff = notifyInitialBackoff
+				err = fs.forward(stream, kernel)
+			}
+		}
+		if status.Code(err) == codes.Unimplemented {
+			log.Info("Notify not supported by server, kernel caches rely on timeouts")
+			return
+		}
+		if fs.ctx.Err() != nil {
+			return
+		}
+		log.Warnf("Notify: %v, resubscribing in %v", err, backoff)
+
+		select {
+		case <-time.After(backoff):
+		case <-fs.ctx.Done():
+			return
+		}
+		if backoff *= 2; backoff > notifyMaxBackoff {
+			backoff = notifyMaxBackoff
+		}
+	}
+}
+
+// forward passes the events of stream to kernel until the stream fails.
+func (fs *fileSystem) forward(stream pb.RawFileSystem_NotifyClient, kernel KernelNotifier) error {
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		var st fuse.Status
+		switch ev := res.Event.(type) {
+		case *pb.NotifyResponse_Inode:
+			fs.cache.dropAttr(ev.Inode.Node)
+			if ev.Inode.Offset >= 0 {
+				fs.readCache.drop(ev.Inode.Node)
+			}
+			st = kernel.InodeNotify(ev.Inode.Node, ev.Inode.Offset, ev.Inode.Length)
+		case *pb.NotifyResponse_Entry:
+			fs.cache.dropEntry(ev.Entry.Parent, ev.Entry.Name)
+			st = kernel.EntryNotify(ev.Entry.Parent, ev.Entry.Name)
+		default:
+			continue
+		}
+		// ENOENT only means the kernel has nothing cached
+		if st != fuse.OK && st != fuse.ENOENT {
+			log.Debugf("Notify %v: %v", res, st)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package grpc2fuse_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+type testKernel struct {
+	inodes  []uint64
+	entries []string
+}
+
+func (k *testKernel) InodeNotify(node uint64, off int64, length int64) fuse.Status {
+	k.inodes = append(k.inodes, node)
+	return fuse.OK
+}
+
+func (k *testKernel) EntryNotify(parent uint64, name string) fuse.Status {
+	k.entries = append(k.entries, name)
+	return fuse.ENOENT
+}
+
+func TestWatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+	stream := mock.NewMockRawFileSystem_NotifyClient(ctrl)
+
+	client.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any()).Return(stream, nil)
+	stream.EXPECT().Header().Return(nil, nil)
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(&pb.NotifyResponse{Event: &pb.NotifyResponse_Inode{
+			Inode: &pb.InodeNotify{Node: 2, Offset: -1},
+		}}, nil),
+		stream.EXPECT().Recv().Return(&pb.NotifyResponse{Event: &pb.NotifyResponse_Entry{
+			Entry: &pb.EntryNotify{Parent: 1, Name: "foo"},
+		}}, nil),
+		// Watch gives up on servers without Notify
+		stream.EXPECT().Recv().Return(nil, status.Error(codes.Unimplemented, "")),
+	)
+
+	kernel := &testKernel{}
+	grpc2fuse.NewFileSystem(client, grpc2fuse.WithCache(0)).Watch(kernel)
+
+	require.Equal(t, []uint64{2}, kernel.inodes)
+	require.Equal(t, []string{"foo"}, kernel.entries)
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc2fuse
+
+import (
+	"syscall"
+
+	"google.golang.org/grpc"
+)
+
+// Option configures the file system returned by NewFileSystem.
+//
+// Options are grpc.CallOptions, so they can be passed to NewFileSystem
+// together with the call options used for every request. NewFileSystem
+// applies them and strips them from the call options.
+type Option interface {
+	grpc.CallOption
+
+	apply(fs *fileSystem)
+}
+
+type optionFunc struct {
+	grpc.EmptyCallOption
+
+	f func(fs *fileSystem)
+}
+
+func (o optionFunc) apply(fs *fileSystem) {
+	o.f(fs)
+}
+
+// WithMsgSizeThreshold sets the size above which the data of a Write is
+// split over several messages.
+func WithMsgSizeThreshold(threshold int) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		if threshold > 0 {
+			fs.msgSizeThreshold = threshold
+		}
+	}}
+}
+
+// WithCache enables the client-side attribute and entry cache. Entries
+// are served locally until AttrValid/EntryValid returned by the server
+// expires. size limits the number of cached items, 0 means
+// defaultCacheSize.
+func WithCache(size int) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		fs.cache = newAttrCache(size)
+	}}
+}
+
+// WithClientID sets the id the server tracks the handles, lookups and
+// locks of this client under. A client reconnecting with the same id
+// keeps them, by default a random id is used.
+func WithClientID(id string) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		fs.clientID = id
+	}}
+}
+
+// WithExport mounts the export name of a server serving several file
+// systems instead of its default one.
+func WithExport(name string) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		fs.export = name
+	}}
+}
+
+// WithUnavailableErrno sets the errno requests fail with while the
+// server is unavailable. The default ENOTCONN tells applications the
+// file system is gone, EAGAIN invites them to try again.
+func WithUnavailableErrno(errno syscall.Errno) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		fs.unavailableErrno = errno
+	}}
+}
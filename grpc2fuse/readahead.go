@@ -0,0 +1,294 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc2fuse
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+const (
+	defaultReadaheadWindow  = 1 << 20
+	defaultReadaheadWindows = 4
+	defaultReadCacheSize    = 64 << 20
+)
+
+// WithReadahead prefetches the next windows windows of window bytes of
+// file handles read sequentially, and keeps up to cacheSize bytes of
+// them in memory. Zero values select the defaults.
+func WithReadahead(window, windows int, cacheSize int64) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		if window <= 0 {
+			window = defaultReadaheadWindow
+		}
+		if windows <= 0 {
+			windows = defaultReadaheadWindows
+		}
+		if cacheSize <= 0 {
+			cacheSize = defaultReadCacheSize
+		}
+		fs.readCache = newReadCache(int64(window), windows, cacheSize)
+	}}
+}
+
+// page is one window of a file handle, read from the server in the
+// background.
+type page struct {
+	file *fileCache
+	off  int64
+	// size is the number of bytes page holds in the cache, the window
+	// while it is being read.
+	size int64
+
+	// data and st are set once done is closed.
+	data []byte
+	st   fuse.Status
+	done chan struct{}
+
+	// elem is the element of the page in the LRU list once it is read.
+	elem *list.Element
+}
+
+// fileCache holds the pages of one file handle.
+type fileCache struct {
+	fh   uint64
+	node uint64
+	// next is where a sequential read continues.
+	next int64
+	// eof is the size of the file found by a short read, -1 if unknown.
+	eof   int64
+	pages map[int64]*page
+}
+
+// readCache detects sequential reads of file handles and reads the
+// windows ahead of them concurrently. The pages are kept until the
+// reader has passed them, the cache is full or they are invalidated by
+// a change of the file.
+//
+// All methods are safe to call on a nil *readCache, which is how
+// readahead is disabled.
+type readCache struct {
+	mu sync.Mutex
+
+	window  int64
+	windows int
+	maxSize int64
+
+	size  int64
+	files map[uint64]*fileCache
+	// lru holds the pages read, most recently used in front.
+	lru *list.List
+}
+
+func newReadCache(window int64, windows int, maxSize int64) *readCache {
+	return &readCache{
+		window:  window,
+		windows: windows,
+		maxSize: maxSize,
+		files:   make(map[uint64]*fileCache),
+		lru:     list.New(),
+	}
+}
+
+// read serves in from the cache, reading the missing windows with
+// fetch. It returns false if in is not part of a sequential read and
+// has to be sent to the server as is.
+func (c *readCache) read(cancel <-chan struct{}, in *fuse.ReadIn, buf []byte, fetch func(in *fuse.ReadIn) ([]byte, fuse.Status)) (int, fuse.Status, bool) {
+	if c == nil {
+		return 0, fuse.OK, false
+	}
+	off, end := int64(in.Offset), int64(in.Offset)+int64(in.Size)
+	if int64(len(buf)) > int64(in.Size) {
+		buf = buf[:in.Size]
+	}
+
+	c.mu.Lock()
+	f := c.files[in.Fh]
+	if f == nil {
+		f = &fileCache{fh: in.Fh, node: in.NodeId, eof: -1, pages: make(map[int64]*page)}
+		c.files[in.Fh] = f
+	}
+	first := off - off%c.window
+	if _, ok := f.pages[first]; !ok && off != f.next {
+		f.next = end
+		c.mu.Unlock()
+		return 0, fuse.OK, false
+	}
+	f.next = end
+
+	// the pages of in itself, then the windows ahead
+	var pages []*page
+	p := first
+	for ; p < end; p += c.window {
+		pg := c.page(f, p, in, fetch)
+		if pg == nil {
+			c.mu.Unlock()
+			return 0, fuse.OK, false
+		}
+		pages = append(pages, pg)
+	}
+	for i := 0; i < c.windows; i, p = i+1, p+c.window {
+		if f.eof >= 0 && p >= f.eof {
+			break
+		}
+		if c.page(f, p, in, fetch) == nil {
+			break
+		}
+	}
+	c.dropBehind(f, first)
+	c.mu.Unlock()
+
+	n := 0
+	for _, pg := range pages {
+		select {
+		case <-pg.done:
+		case <-cancel:
+			return 0, fuse.EINTR, true
+		}
+		if pg.st != fuse.OK {
+			if n == 0 {
+				return 0, pg.st, true
+			}
+			break
+		}
+		start := off + int64(n) - pg.off
+		if start >= int64(len(pg.data)) {
+			break
+		}
+		n += copy(buf[n:], pg.data[start:])
+		if int64(len(pg.data)) < c.window {
+			// end of file
+			break
+		}
+	}
+	return n, fuse.OK, true
+}
+
+// page returns the page of f at off, starting to read it if it is not
+// cached. It returns nil if the cache is full. The caller holds mu.
+func (c *readCache) page(f *fileCache, off int64, in *fuse.ReadIn, fetch func(in *fuse.ReadIn) ([]byte, fuse.Status)) *page {
+	if pg, ok := f.pages[off]; ok {
+		if pg.elem != nil {
+			c.lru.MoveToFront(pg.elem)
+		}
+		return pg
+	}
+	for c.size+c.window > c.maxSize {
+		back := c.lru.Back()
+		if back == nil {
+			return nil
+		}
+		c.remove(back.Value.(*page))
+	}
+
+	pg := &page{file: f, off: off, size: c.window, done: make(chan struct{})}
+	f.pages[off] = pg
+	c.size += pg.size
+
+	// in belongs to the kernel request, which is gone by the time the
+	// page is read
+	req := *in
+	req.Offset = uint64(off)
+	req.Size = uint32(c.window)
+	go c.fill(pg, &req, fetch)
+	return pg
+}
+
+func (c *readCache) fill(pg *page, in *fuse.ReadIn, fetch func(in *fuse.ReadIn) ([]byte, fuse.Status)) {
+	data, st := fetch(in)
+
+	c.mu.Lock()
+	pg.data, pg.st = data, st
+	f := pg.file
+	if f.pages[pg.off] == pg {
+		if st != fuse.OK {
+			// do not cache errors
+			c.remove(pg)
+		} else {
+			c.size += int64(len(data)) - pg.size
+			pg.size = int64(len(data))
+			pg.elem = c.lru.PushFront(pg)
+			if int64(len(data)) < c.window {
+				f.eof = pg.off + int64(len(data))
+			}
+		}
+	}
+	c.mu.Unlock()
+
+	close(pg.done)
+}
+
+// dropBehind removes the pages of f the reader has passed. The caller
+// holds mu.
+func (c *readCache) dropBehind(f *fileCache, off int64) {
+	for _, pg := range f.pages {
+		if pg.off < off && pg.elem != nil {
+			c.remove(pg)
+		}
+	}
+}
+
+// remove drops pg from the cache, the caller holds mu.
+func (c *readCache) remove(pg *page) {
+	if pg.file.pages[pg.off] != pg {
+		return
+	}
+	delete(pg.file.pages, pg.off)
+	c.size -= pg.size
+	if pg.elem != nil {
+		c.lru.Remove(pg.elem)
+		pg.elem = nil
+	}
+}
+
+func (c *readCache) reset(f *fileCache) {
+	for _, pg := range f.pages {
+		c.remove(pg)
+	}
+	f.eof = -1
+}
+
+// drop invalidates the pages of all handles of node, the file changed.
+func (c *readCache) drop(node uint64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, f := range c.files {
+		if f.node == node {
+			c.reset(f)
+		}
+	}
+}
+
+// release forgets the handle fh.
+func (c *readCache) release(fh uint64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if f, ok := c.files[fh]; ok {
+		c.reset(f)
+		delete(c.files, fh)
+	}
+}
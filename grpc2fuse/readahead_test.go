@@ -0,0 +1,97 @@
+package grpc2fuse_test
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+// readStream sends data in one message, prefetches may still be reading
+// when the test ends so it can not be a mock.
+type readStream struct {
+	grpc.ClientStream
+	data []byte
+	sent bool
+}
+
+func (s *readStream) Recv() (*pb.ReadResponse, error) {
+	if s.sent {
+		return nil, io.EOF
+	}
+	s.sent = true
+	return &pb.ReadResponse{Buffer: s.data, Status: &pb.Status{}}, nil
+}
+
+func TestReadahead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+	fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithReadahead(4, 2, 1<<10))
+
+	content := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+
+	var (
+		mu      sync.Mutex
+		fetched = make(map[uint64]int)
+	)
+	client.EXPECT().Read(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *pb.ReadRequest, opts ...grpc.CallOption) (pb.RawFileSystem_ReadClient, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			fetched[in.ReadIn.Offset]++
+
+			off, end := int(in.ReadIn.Offset), int(in.ReadIn.Offset)+int(in.ReadIn.Size)
+			if off > len(content) {
+				off = len(content)
+			}
+			if end > len(content) {
+				end = len(content)
+			}
+			return &readStream{data: append([]byte(nil), content[off:end]...)}, nil
+		}).AnyTimes()
+
+	read := func(off, size int) string {
+		buf := make([]byte, size)
+		res, st := fs.Read(nil, &fuse.ReadIn{InHeader: fuse.InHeader{NodeId: 2}, Fh: 1, Offset: uint64(off), Size: uint32(size)}, buf)
+		require.Equal(t, fuse.OK, st)
+		data, st := res.Bytes(buf)
+		require.Equal(t, fuse.OK, st)
+		return string(data)
+	}
+
+	// sequential reads across windows
+	for off := 0; off < len(content); off += 3 {
+		end := off + 3
+		if end > len(content) {
+			end = len(content)
+		}
+		require.Equal(t, string(content[off:end]), read(off, 3))
+	}
+	require.Equal(t, "", read(len(content), 3))
+
+	// every window was read once
+	mu.Lock()
+	for off, n := range fetched {
+		require.Equal(t, 1, n, off)
+	}
+	mu.Unlock()
+
+	// a write drops the cached pages
+	client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(&pb.WriteResponse{Written: 1, Status: &pb.Status{}}, nil)
+	_, st := fs.Write(nil, &fuse.WriteIn{InHeader: fuse.InHeader{NodeId: 2}, Fh: 1, Offset: 0, Size: 1}, []byte("X"))
+	require.Equal(t, fuse.OK, st)
+
+	mu.Lock()
+	content[32] = 'X'
+	mu.Unlock()
+	require.Equal(t, "Xxyz", read(32, 4))
+}
@@ -20,10 +20,17 @@ import (
 	"github.com/chiyutianyi/grpcfuse/pb"
 
 	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
 )
 
 func (fs *fileSystem) Release(cancel <-chan struct{}, in *fuse.ReleaseIn) {
-	ctx := newContext(cancel)
+	fs.readCache.release(in.Fh)
+	if st := fs.writeBack.release(in.Fh); st != fuse.OK {
+		log.Errorf("Release: write back of handle %d failed: %v", in.Fh, st)
+	}
+
+	ctx, done := fs.newContext(cancel, "Release")
+	defer done()
 
 	if _, err := fs.client.Release(ctx, &pb.ReleaseRequest{
 		Header:       toPbHeader(&in.InHeader),
@@ -32,6 +39,6 @@ func (fs *fileSystem) Release(cancel <-chan struct{}, in *fuse.ReleaseIn) {
 		ReleaseFlags: in.ReleaseFlags,
 		LockOwner:    in.LockOwner,
 	}, fs.opts...); err != nil {
-		dealGrpcError("Release", err)
+		fs.dealGrpcError("Release", err)
 	}
 }
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc2fuse
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy controls how idempotent requests are retried while the
+// server is unavailable, e.g. because it is restarting. Other requests
+// are never retried, they fail with the errno set by
+// WithUnavailableErrno instead.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts including the first one.
+	// 0 retries until the server is back or the request is
+	// interrupted.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff time.Duration
+	// Multiplier grows the delay after each retry.
+	Multiplier float64
+}
+
+// DefaultRetryPolicy retries for about a minute. The timeout of the
+// request applies to every attempt.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    15,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Multiplier:     2,
+}
+
+// idempotent are the requests that can be safely sent again.
+var idempotent = map[string]bool{
+	"Init":        true,
+	"GetAttr":     true,
+	"Lookup":      true,
+	"Read":        true,
+	"ReadDir":     true,
+	"ReadDirPlus": true,
+	"StatFs":      true,
+	"GetXAttr":    true,
+	"ListXAttr":   true,
+}
+
+// WithRetryPolicy retries idempotent requests failing with
+// codes.Unavailable according to policy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		fs.retryPolicy = &policy
+	}}
+}
+
+// retry calls fn until it returns anything but codes.Unavailable, or the
+// retry policy gives up. Requests which are not idempotent are tried
+// only once. ctx comes from newRetryContext, every attempt gets the
+// timeout of method on top of it.
+func (fs *fileSystem) retry(ctx context.Context, method string, fn func(ctx context.Context, opts []grpc.CallOption) error) error {
+	policy := fs.retryPolicy
+	if policy == nil || !idempotent[method] {
+		return fn(ctx, fs.opts)
+	}
+
+	try := func() error {
+		ctx, stop := ctx, context.CancelFunc(func() {})
+		if d := fs.timeout(method); d > 0 {
+			ctx, stop = context.WithTimeout(ctx, d)
+		}
+		defer stop()
+		return fn(ctx, fs.opts)
+	}
+
+	backoff := policy.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := try()
+		if status.Code(err) != codes.Unavailable {
+			return err
+		}
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			log.Errorf("%s: giving up after %d attempts", method, attempt)
+			return err
+		}
+
+		log.Warnf("%s: server unavailable, retrying in %v", method, backoff)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		backoff = time.Duration(float64(backoff) * policy.Multiplier)
+		if backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+	}
+}
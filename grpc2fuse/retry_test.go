@@ -0,0 +1,100 @@
+package grpc2fuse_test
+
+import (
+	"context"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+func TestRetry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+	fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithRetryPolicy(grpc2fuse.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		Multiplier:     2,
+	}))
+	log.SetLevel(log.PanicLevel)
+
+	unavailable := status.Error(codes.Unavailable, "Unavailable")
+
+	// idempotent requests are retried
+	gomock.InOrder(
+		client.EXPECT().GetAttr(gomock.Any(), gomock.Any()).Return(nil, unavailable).Times(2),
+		client.EXPECT().GetAttr(gomock.Any(), gomock.Any()).Return(&pb.GetAttrResponse{
+			AttrOut: &pb.AttrOut{Attr: &pb.Attr{Size: 5, Owner: &pb.Owner{}}},
+			Status:  &pb.Status{},
+		}, nil),
+	)
+	var out fuse.AttrOut
+	require.Equal(t, fuse.OK, fs.GetAttr(nil, &fuse.GetAttrIn{InHeader: TestInHeader}, &out))
+	require.Equal(t, uint64(5), out.Size)
+
+	// until the policy gives up
+	client.EXPECT().GetAttr(gomock.Any(), gomock.Any()).Return(nil, unavailable).Times(3)
+	require.Equal(t, fuse.Status(syscall.ENOTCONN), fs.GetAttr(nil, &fuse.GetAttrIn{InHeader: TestInHeader}, &out))
+
+	// the others are not
+	client.EXPECT().Unlink(gomock.Any(), gomock.Any()).Return(nil, unavailable)
+	header := TestInHeader
+	require.Equal(t, fuse.Status(syscall.ENOTCONN), fs.Unlink(nil, &header, "foo"))
+}
+
+func TestRetryTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+	fs := grpc2fuse.NewFileSystem(client,
+		grpc2fuse.WithRetryPolicy(grpc2fuse.RetryPolicy{
+			MaxAttempts:    5,
+			InitialBackoff: 30 * time.Millisecond,
+			MaxBackoff:     30 * time.Millisecond,
+			Multiplier:     1,
+		}),
+		grpc2fuse.WithTimeout("GetAttr", 50*time.Millisecond),
+	)
+	log.SetLevel(log.PanicLevel)
+
+	unavailable := status.Error(codes.Unavailable, "Unavailable")
+
+	// the timeout bounds every attempt, not all of them together
+	start := time.Now()
+	gomock.InOrder(
+		client.EXPECT().GetAttr(gomock.Any(), gomock.Any()).Return(nil, unavailable).Times(3),
+		client.EXPECT().GetAttr(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *pb.GetAttrRequest, opts ...grpc.CallOption) (*pb.GetAttrResponse, error) {
+				deadline, ok := ctx.Deadline()
+				require.True(t, ok)
+				require.WithinDuration(t, time.Now().Add(50*time.Millisecond), deadline, 20*time.Millisecond)
+				return &pb.GetAttrResponse{
+					AttrOut: &pb.AttrOut{Attr: &pb.Attr{Size: 5, Owner: &pb.Owner{}}},
+					Status:  &pb.Status{},
+				}, nil
+			}),
+	)
+	var out fuse.AttrOut
+	require.Equal(t, fuse.OK, fs.GetAttr(nil, &fuse.GetAttrIn{InHeader: TestInHeader}, &out))
+	require.Equal(t, uint64(5), out.Size)
+	require.Greater(t, time.Since(start), 50*time.Millisecond)
+
+	// an attempt timing out is not retried
+	client.EXPECT().GetAttr(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *pb.GetAttrRequest, opts ...grpc.CallOption) (*pb.GetAttrResponse, error) {
+			<-ctx.Done()
+			return nil, status.FromContextError(ctx.Err()).Err()
+		})
+	require.Equal(t, fuse.Status(syscall.ETIMEDOUT), fs.GetAttr(nil, &fuse.GetAttrIn{InHeader: TestInHeader}, &out))
+}
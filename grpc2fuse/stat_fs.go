@@ -17,19 +17,27 @@
 package grpc2fuse
 
 import (
+	"context"
+
 	"github.com/chiyutianyi/grpcfuse/pb"
 
 	"github.com/hanwen/go-fuse/v2/fuse"
+	"google.golang.org/grpc"
 )
 
 func (fs *fileSystem) StatFs(cancel <-chan struct{}, in *fuse.InHeader, out *fuse.StatfsOut) (code fuse.Status) {
-	ctx := newContext(cancel)
-
-	res, err := fs.client.StatFs(ctx, &pb.StatfsRequest{
-		Input: toPbHeader(in),
-	}, fs.opts...)
-
-	if st := dealGrpcError("StatFs", err); st != fuse.OK {
+	ctx, done := fs.newRetryContext(cancel, "StatFs")
+	defer done()
+
+	var res *pb.StatfsResponse
+	err := fs.retry(ctx, "StatFs", func(ctx context.Context, opts []grpc.CallOption) (err error) {
+		res, err = fs.client.StatFs(ctx, &pb.StatfsRequest{
+			Input: toPbHeader(in),
+		}, opts...)
+		return err
+	})
+
+	if st := fs.dealGrpcError("StatFs", err); st != fuse.OK {
 		return st
 	}
 
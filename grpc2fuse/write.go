@@ -0,0 +1,104 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc2fuse
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+// doWrite sends req with a unary Write if the data fits into one
+// message, and splits it over a WriteStream otherwise. Servers without
+// WriteStream get unary Writes from then on.
+func (fs *fileSystem) doWrite(ctx context.Context, req *pb.WriteRequest) (*pb.WriteResponse, error) {
+	if len(req.Data) <= fs.msgSizeThreshold || atomic.LoadInt32(&fs.noWriteStream) != 0 {
+		fs.signWrite(req)
+		res, err := fs.client.Write(ctx, req, fs.compressOpts(fs.opts, len(req.Data))...)
+		return res, fs.sizeError(err, req)
+	}
+
+	res, err := fs.writeStream(ctx, req)
+	if status.Code(err) == codes.Unimplemented {
+		log.Warnf("WriteStream unimplemented, falling back to Write")
+		atomic.StoreInt32(&fs.noWriteStream, 1)
+		fs.signWrite(req)
+		res, err := fs.client.Write(ctx, req, fs.compressOpts(fs.opts, len(req.Data))...)
+		return res, fs.sizeError(err, req)
+	}
+	return res, err
+}
+
+func (fs *fileSystem) writeStream(ctx context.Context, req *pb.WriteRequest) (*pb.WriteResponse, error) {
+	stream, err := fs.client.WriteStream(ctx, fs.compressOpts(fs.opts, len(req.Data))...)
+	if err != nil {
+		return nil, err
+	}
+
+	data := req.Data
+	defer func() { req.Data = data }()
+
+	for pos := 0; pos < len(data); pos += fs.msgSizeThreshold {
+		end := pos + fs.msgSizeThreshold
+		if end > len(data) {
+			end = len(data)
+		}
+
+		msg := &pb.WriteRequest{Data: data[pos:end]}
+		if pos == 0 {
+			req.Data = data[:end]
+			msg = req
+		}
+		fs.signWrite(msg)
+
+		// io.EOF means the server has finished the stream, the
+		// actual status is returned by CloseAndRecv.
+		if err := stream.Send(msg); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+// writeBehind sends a batch of the write back buffer.
+func (fs *fileSystem) writeBehind(req *pb.WriteRequest) fuse.Status {
+	ctx, done := fs.newContext(nil, "Write")
+	defer done()
+	res, err := fs.doWrite(ctx, req)
+	fs.cache.dropAttr(req.Header.GetNodeId())
+	fs.readCache.drop(req.Header.GetNodeId())
+
+	if st := fs.dealGrpcError("Write", err); st != fuse.OK {
+		return st
+	}
+	if st := fuse.Status(res.Status.GetCode()); st != fuse.OK {
+		return st
+	}
+	if int(res.Written) < len(req.Data) {
+		return fuse.EIO
+	}
+	return fuse.OK
+}
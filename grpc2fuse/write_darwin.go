@@ -23,18 +23,28 @@ import (
 )
 
 func (fs *fileSystem) Write(cancel <-chan struct{}, input *fuse.WriteIn, data []byte) (written uint32, code fuse.Status) {
-	ctx := newContext(cancel)
-
-	res, err := fs.client.Write(ctx, &pb.WriteRequest{
+	req := &pb.WriteRequest{
 		Header:     toPbHeader(&input.InHeader),
 		Fh:         input.Fh,
 		Offset:     input.Offset,
 		Data:       data,
 		Size:       input.Size,
 		WriteFlags: input.WriteFlags,
-	}, fs.opts...)
+	}
+	if written, st, ok := fs.writeBack.write(req); ok {
+		fs.cache.dropAttr(input.NodeId)
+		fs.readCache.drop(input.NodeId)
+		return written, st
+	}
+
+	ctx, done := fs.newContext(cancel, "Write")
+	defer done()
+
+	res, err := fs.doWrite(ctx, req)
+	fs.cache.dropAttr(input.NodeId)
+	fs.readCache.drop(input.NodeId)
 
-	if st := dealGrpcError("Write", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Write", err); st != fuse.OK {
 		return 0, st
 	}
 
@@ -23,9 +23,7 @@ import (
 )
 
 func (fs *fileSystem) Write(cancel <-chan struct{}, input *fuse.WriteIn, data []byte) (written uint32, code fuse.Status) {
-	ctx := newContext(cancel)
-
-	res, err := fs.client.Write(ctx, &pb.WriteRequest{
+	req := &pb.WriteRequest{
 		Header:     toPbHeader(&input.InHeader),
 		Fh:         input.Fh,
 		Offset:     input.Offset,
@@ -35,9 +33,21 @@ func (fs *fileSystem) Write(cancel <-chan struct{}, input *fuse.WriteIn, data []
 		LockOwner:  input.LockOwner,
 		Flags:      input.Flags,
 		Padding:    input.Padding,
-	}, fs.opts...)
+	}
+	if written, st, ok := fs.writeBack.write(req); ok {
+		fs.cache.dropAttr(input.NodeId)
+		fs.readCache.drop(input.NodeId)
+		return written, st
+	}
+
+	ctx, done := fs.newContext(cancel, "Write")
+	defer done()
+
+	res, err := fs.doWrite(ctx, req)
+	fs.cache.dropAttr(input.NodeId)
+	fs.readCache.drop(input.NodeId)
 
-	if st := dealGrpcError("Write", err); st != fuse.OK {
+	if st := fs.dealGrpcError("Write", err); st != fuse.OK {
 		return 0, st
 	}
 
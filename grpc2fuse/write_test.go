@@ -0,0 +1,73 @@
+package grpc2fuse_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+func TestWriteStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+	fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithMsgSizeThreshold(5))
+	log.SetLevel(log.ErrorLevel)
+
+	data := []byte("hello world")
+	in := fuse.WriteIn{InHeader: TestInHeader, Size: uint32(len(data))}
+
+	var chunks []string
+	stream := mock.NewMockRawFileSystem_WriteStreamClient(ctrl)
+	client.EXPECT().WriteStream(gomock.Any()).Return(stream, nil)
+	stream.EXPECT().Send(gomock.Any()).Times(3).DoAndReturn(func(req *pb.WriteRequest) error {
+		chunks = append(chunks, string(req.Data))
+		return nil
+	})
+	stream.EXPECT().CloseAndRecv().Return(&pb.WriteResponse{Written: 11, Status: &pb.Status{}}, nil)
+
+	written, st := fs.Write(nil, &in, data)
+	require.Equal(t, fuse.OK, st)
+	require.Equal(t, uint32(11), written)
+	require.Equal(t, []string{"hello", " worl", "d"}, chunks)
+
+	// small writes are unary
+	client.EXPECT().Write(gomock.Any(), gomock.Any()).Return(&pb.WriteResponse{Written: 2, Status: &pb.Status{}}, nil)
+	written, st = fs.Write(nil, &in, data[:2])
+	require.Equal(t, fuse.OK, st)
+	require.Equal(t, uint32(2), written)
+}
+
+func TestWriteStreamFallback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+	fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithMsgSizeThreshold(5))
+	log.SetLevel(log.ErrorLevel)
+
+	data := []byte("hello world")
+	in := fuse.WriteIn{InHeader: TestInHeader, Size: uint32(len(data))}
+
+	stream := mock.NewMockRawFileSystem_WriteStreamClient(ctrl)
+	client.EXPECT().WriteStream(gomock.Any()).Return(stream, nil)
+	stream.EXPECT().Send(gomock.Any()).Return(nil).AnyTimes()
+	stream.EXPECT().CloseAndRecv().Return(nil, status.Error(codes.Unimplemented, "Unimplemented"))
+
+	client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+		func(_ interface{}, req *pb.WriteRequest, _ ...interface{}) (*pb.WriteResponse, error) {
+			require.Equal(t, data, req.Data)
+			return &pb.WriteResponse{Written: uint32(len(req.Data)), Status: &pb.Status{}}, nil
+		})
+
+	for i := 0; i < 2; i++ {
+		written, st := fs.Write(nil, &in, data)
+		require.Equal(t, fuse.OK, st)
+		require.Equal(t, uint32(11), written)
+	}
+}
@@ -0,0 +1,256 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc2fuse
+
+import (
+	"sync"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+const (
+	defaultWriteBackBatch = 1 << 20
+	// maxQueuedBatches is the number of batches of a handle waiting to
+	// be sent before Write blocks.
+	maxQueuedBatches = 4
+)
+
+// WithWriteBack buffers the writes of each file handle and sends them
+// in the background, merging adjacent and overlapping writes into
+// batches of up to batch bytes, 0 means defaultWriteBackBatch. Buffered
+// data is sent before Flush, Fsync and Release return, which report the
+// errors of earlier writes like NFS does.
+func WithWriteBack(batch int) Option {
+	return optionFunc{f: func(fs *fileSystem) {
+		if batch <= 0 {
+			batch = defaultWriteBackBatch
+		}
+		fs.writeBack = newWriteBack(batch, fs.writeBehind)
+	}}
+}
+
+// dirtyFile is the data written to a file handle which has not reached
+// the server yet.
+type dirtyFile struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	node uint64
+	// dirty is the batch writes are merged into.
+	dirty *pb.WriteRequest
+	// queue holds the batches being sent, in order.
+	queue   []*pb.WriteRequest
+	queued  int
+	sending bool
+	// err is the first error of a batch sent, reported by the next
+	// Flush or Fsync.
+	err fuse.Status
+}
+
+// writeBack buffers writes per file handle.
+//
+// All methods are safe to call on a nil *writeBack, which is how write
+// back is disabled.
+type writeBack struct {
+	mu    sync.Mutex
+	batch int
+	files map[uint64]*dirtyFile
+	send  func(req *pb.WriteRequest) fuse.Status
+}
+
+func newWriteBack(batch int, send func(req *pb.WriteRequest) fuse.Status) *writeBack {
+	return &writeBack{
+		batch: batch,
+		files: make(map[uint64]*dirtyFile),
+		send:  send,
+	}
+}
+
+// write buffers req. It returns false if write back is disabled.
+func (w *writeBack) write(req *pb.WriteRequest) (uint32, fuse.Status, bool) {
+	if w == nil {
+		return 0, fuse.OK, false
+	}
+
+	w.mu.Lock()
+	f := w.files[req.Fh]
+	if f == nil {
+		f = &dirtyFile{node: req.Header.GetNodeId()}
+		f.cond = sync.NewCond(&f.mu)
+		w.files[req.Fh] = f
+	}
+	w.mu.Unlock()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for f.queued >= maxQueuedBatches*w.batch {
+		f.cond.Wait()
+	}
+
+	if d := f.dirty; d != nil {
+		start := int64(req.Offset) - int64(d.Offset)
+		end := start + int64(len(req.Data))
+		switch {
+		case start < 0 || start > int64(len(d.Data)) || end > int64(w.batch):
+			w.enqueue(f)
+		case end > int64(len(d.Data)):
+			d.Data = append(d.Data[:start], req.Data...)
+		default:
+			copy(d.Data[start:], req.Data)
+		}
+	}
+	if f.dirty == nil {
+		// the data belongs to the kernel request
+		f.dirty = proto.Clone(req).(*pb.WriteRequest)
+		f.dirty.Data = append(make([]byte, 0, w.batch), req.Data...)
+	}
+	if len(f.dirty.Data) >= w.batch {
+		w.enqueue(f)
+	}
+	return uint32(len(req.Data)), fuse.OK, true
+}
+
+// enqueue sends the dirty batch of f in the background, the caller holds
+// f.mu.
+func (w *writeBack) enqueue(f *dirtyFile) {
+	if f.dirty == nil {
+		return
+	}
+	f.dirty.Size = uint32(len(f.dirty.Data))
+	f.queue = append(f.queue, f.dirty)
+	f.queued += len(f.dirty.Data)
+	f.dirty = nil
+
+	if !f.sending {
+		f.sending = true
+		go w.drain(f)
+	}
+}
+
+// drain sends the queue of f until it is empty.
+func (w *writeBack) drain(f *dirtyFile) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for len(f.queue) > 0 {
+		req := f.queue[0]
+		f.mu.Unlock()
+		st := w.send(req)
+		f.mu.Lock()
+
+		f.queue = f.queue[1:]
+		f.queued -= len(req.Data)
+		if st != fuse.OK && f.err == fuse.OK {
+			f.err = st
+		}
+		f.cond.Broadcast()
+	}
+	f.sending = false
+	f.cond.Broadcast()
+}
+
+// wait sends the data buffered for f and waits for it. If report is
+// set, the error of earlier batches is returned and cleared.
+func (w *writeBack) wait(f *dirtyFile, report bool) fuse.Status {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.enqueue(f)
+	for f.sending {
+		f.cond.Wait()
+	}
+	if !report {
+		return fuse.OK
+	}
+	st := f.err
+	f.err = fuse.OK
+	return st
+}
+
+// flush sends the data buffered for fh, and returns the first error of
+// its writes since the last flush.
+func (w *writeBack) flush(fh uint64) fuse.Status {
+	if w == nil {
+		return fuse.OK
+	}
+	w.mu.Lock()
+	f := w.files[fh]
+	w.mu.Unlock()
+
+	if f == nil {
+		return fuse.OK
+	}
+	return w.wait(f, true)
+}
+
+// flushNode sends the data buffered for all handles of node, before
+// node is read or its attributes are needed. Errors are kept for the
+// next flush of the handles.
+func (w *writeBack) flushNode(nodes ...uint64) {
+	if w == nil {
+		return
+	}
+	var files []*dirtyFile
+	w.mu.Lock()
+	for _, f := range w.files {
+		for _, node := range nodes {
+			if f.node == node {
+				files = append(files, f)
+			}
+		}
+	}
+	w.mu.Unlock()
+
+	for _, f := range files {
+		w.wait(f, false)
+	}
+}
+
+// release is flush for the last time.
+func (w *writeBack) release(fh uint64) fuse.Status {
+	st := w.flush(fh)
+	if w != nil {
+		w.mu.Lock()
+		delete(w.files, fh)
+		w.mu.Unlock()
+	}
+	return st
+}
+
+// flushAll sends the data of all handles, it is called on unmount.
+func (w *writeBack) flushAll() {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	fhs := make([]uint64, 0, len(w.files))
+	for fh := range w.files {
+		fhs = append(fhs, fh)
+	}
+	w.mu.Unlock()
+
+	for _, fh := range fhs {
+		if st := w.release(fh); st != fuse.OK {
+			log.Errorf("Write back of handle %d: %v", fh, st)
+		}
+	}
+}
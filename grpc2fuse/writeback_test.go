@@ -0,0 +1,77 @@
+package grpc2fuse_test
+
+import (
+	"context"
+	"syscall"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/chiyutianyi/grpcfuse/grpc2fuse"
+	"github.com/chiyutianyi/grpcfuse/mock"
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+func TestWriteBack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock.NewMockRawFileSystemClient(ctrl)
+	fs := grpc2fuse.NewFileSystem(client, grpc2fuse.WithWriteBack(8))
+
+	type write struct {
+		offset uint64
+		data   string
+	}
+	var writes []write
+	sent := func(code int32) func(ctx context.Context, in *pb.WriteRequest, opts ...grpc.CallOption) (*pb.WriteResponse, error) {
+		return func(ctx context.Context, in *pb.WriteRequest, opts ...grpc.CallOption) (*pb.WriteResponse, error) {
+			require.Equal(t, uint32(len(in.Data)), in.Size)
+			writes = append(writes, write{in.Offset, string(in.Data)})
+			return &pb.WriteResponse{Written: in.Size, Status: &pb.Status{Code: code}}, nil
+		}
+	}
+	gomock.InOrder(
+		client.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(sent(0)),
+		client.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(sent(0)),
+		client.EXPECT().Flush(gomock.Any(), gomock.Any()).Return(&pb.FlushResponse{Status: &pb.Status{}}, nil),
+		client.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(sent(int32(syscall.ENOSPC))),
+		// the server sees the close of the lock owner despite the error
+		client.EXPECT().Flush(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *pb.FlushRequest, opts ...grpc.CallOption) (*pb.FlushResponse, error) {
+				require.Equal(t, uint64(7), in.LockOwner)
+				return &pb.FlushResponse{Status: &pb.Status{}}, nil
+			}),
+		client.EXPECT().Flush(gomock.Any(), gomock.Any()).Return(&pb.FlushResponse{Status: &pb.Status{}}, nil),
+		client.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(sent(int32(syscall.EIO))),
+		client.EXPECT().Fsync(gomock.Any(), gomock.Any()).Return(&pb.FsyncResponse{Status: &pb.Status{}}, nil),
+	)
+
+	writeAt := func(offset uint64, data string) {
+		written, st := fs.Write(nil, &fuse.WriteIn{InHeader: fuse.InHeader{NodeId: 2}, Fh: 1, Offset: offset, Size: uint32(len(data))}, []byte(data))
+		require.Equal(t, fuse.OK, st)
+		require.Equal(t, uint32(len(data)), written)
+	}
+	flush := func() fuse.Status {
+		return fs.Flush(nil, &fuse.FlushIn{InHeader: fuse.InHeader{NodeId: 2}, Fh: 1, LockOwner: 7})
+	}
+
+	// adjacent and overlapping writes are merged, a gap starts a new
+	// batch
+	writeAt(0, "ab")
+	writeAt(2, "cd")
+	writeAt(1, "XY")
+	writeAt(10, "ef")
+	require.Equal(t, fuse.OK, flush())
+	require.Equal(t, []write{{0, "aXYd"}, {10, "ef"}}, writes)
+
+	// errors of writes are reported by the next flush
+	writeAt(12, "gh")
+	require.Equal(t, fuse.Status(syscall.ENOSPC), flush())
+	require.Equal(t, fuse.OK, flush())
+
+	// and so by fsync, which is sent all the same
+	writeAt(14, "ij")
+	require.Equal(t, fuse.EIO, fs.Fsync(nil, &fuse.FsyncIn{InHeader: fuse.InHeader{NodeId: 2}, Fh: 1}))
+}
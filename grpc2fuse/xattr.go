@@ -17,49 +17,64 @@
 package grpc2fuse
 
 import (
+	"context"
+
 	"github.com/chiyutianyi/grpcfuse/pb"
 
 	"github.com/hanwen/go-fuse/v2/fuse"
+	"google.golang.org/grpc"
 )
 
 func (fs *fileSystem) GetXAttr(cancel <-chan struct{}, header *fuse.InHeader, attr string, dest []byte) (sz uint32, code fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newRetryContext(cancel, "GetXAttr")
+	defer done()
 
-	res, err := fs.client.GetXAttr(ctx, &pb.GetXAttrRequest{
-		Header: toPbHeader(header),
-		Attr:   attr,
-		Dest:   dest,
-	}, fs.opts...)
+	var res *pb.GetXAttrResponse
+	err := fs.retry(ctx, "GetXAttr", func(ctx context.Context, opts []grpc.CallOption) (err error) {
+		res, err = fs.client.GetXAttr(ctx, &pb.GetXAttrRequest{
+			Header: toPbHeader(header),
+			Attr:   attr,
+			Dest:   dest,
+		}, opts...)
+		return err
+	})
 
-	if st := dealGrpcError("GetXAttr", err); st != fuse.OK {
+	if st := fs.dealGrpcError("GetXAttr", err); st != fuse.OK {
 		return 0, st
 	}
 	return res.Size, fuse.Status(res.Status.Code)
 }
 
 func (fs *fileSystem) ListXAttr(cancel <-chan struct{}, header *fuse.InHeader, dest []byte) (uint32, fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newRetryContext(cancel, "ListXAttr")
+	defer done()
 
-	res, err := fs.client.ListXAttr(ctx, &pb.ListXAttrRequest{
-		Header: toPbHeader(header),
-		Dest:   dest,
-	}, fs.opts...)
+	var res *pb.ListXAttrResponse
+	err := fs.retry(ctx, "ListXAttr", func(ctx context.Context, opts []grpc.CallOption) (err error) {
+		res, err = fs.client.ListXAttr(ctx, &pb.ListXAttrRequest{
+			Header: toPbHeader(header),
+			Dest:   dest,
+		}, opts...)
+		return err
+	})
 
-	if st := dealGrpcError("ListXAttr", err); st != fuse.OK {
+	if st := fs.dealGrpcError("ListXAttr", err); st != fuse.OK {
 		return 0, st
 	}
 	return res.Size, fuse.Status(res.Status.Code)
 }
 
 func (fs *fileSystem) RemoveXAttr(cancel <-chan struct{}, header *fuse.InHeader, attr string) (code fuse.Status) {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "RemoveXAttr")
+	defer done()
 
 	res, err := fs.client.RemoveXAttr(ctx, &pb.RemoveXAttrRequest{
 		Header: toPbHeader(header),
 		Attr:   attr,
 	}, fs.opts...)
+	fs.cache.dropAttr(header.NodeId)
 
-	if st := dealGrpcError("RemoveXAttr", err); st != fuse.OK {
+	if st := fs.dealGrpcError("RemoveXAttr", err); st != fuse.OK {
 		return st
 	}
 	return fuse.Status(res.Status.Code)
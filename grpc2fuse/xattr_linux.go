@@ -23,7 +23,8 @@ import (
 )
 
 func (fs *fileSystem) SetXAttr(cancel <-chan struct{}, input *fuse.SetXAttrIn, attr string, data []byte) fuse.Status {
-	ctx := newContext(cancel)
+	ctx, done := fs.newContext(cancel, "SetXAttr")
+	defer done()
 
 	res, err := fs.client.SetXAttr(ctx, &pb.SetXAttrRequest{
 		Header: toPbHeader(&input.InHeader),
@@ -32,8 +33,9 @@ func (fs *fileSystem) SetXAttr(cancel <-chan struct{}, input *fuse.SetXAttrIn, a
 		Size:   input.Size,
 		Flags:  input.Flags,
 	}, fs.opts...)
+	fs.cache.dropAttr(input.NodeId)
 
-	if st := dealGrpcError("SetXAttr", err); st != fuse.OK {
+	if st := fs.dealGrpcError("SetXAttr", err); st != fuse.OK {
 		return st
 	}
 	return fuse.Status(res.Status.Code)
@@ -0,0 +1,204 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpcfs
+
+import (
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+// dir is an open directory.
+type dir struct {
+	fsys    *FS
+	name    string
+	node    uint64
+	fh      uint64
+	attr    *pb.Attr
+	release func()
+
+	mu sync.Mutex
+	// offset counts the entries read so far, "." and ".." included.
+	offset  uint64
+	pending []fs.DirEntry
+	eof     bool
+	closed  bool
+}
+
+var _ fs.ReadDirFile = (*dir)(nil)
+
+func (d *dir) Stat() (fs.FileInfo, error) {
+	return newFileInfo(d.name, d.attr), nil
+}
+
+func (d *dir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.name, Err: syscall.EISDIR}
+}
+
+// ReadDir returns the next n entries, or all the remaining ones if n <= 0.
+func (d *dir) ReadDir(n int) ([]fs.DirEntry, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for !d.eof && (n <= 0 || len(d.pending) < n) {
+		if err := d.fill(); err != nil {
+			return nil, err
+		}
+	}
+
+	entries := d.pending
+	if n > 0 && len(entries) > n {
+		entries = entries[:n]
+	}
+	d.pending = d.pending[len(entries):]
+	if n > 0 && len(entries) == 0 {
+		return nil, io.EOF
+	}
+	return entries, nil
+}
+
+// fill reads the next batch of entries from the server.
+func (d *dir) fill() error {
+	stream, err := d.fsys.client.ReadDir(d.fsys.ctx, &pb.ReadDirRequest{ReadIn: &pb.ReadIn{
+		Header: d.fsys.header(d.node),
+		Fh:     d.fh,
+		Offset: d.offset,
+		Size:   dirBufferSize,
+	}}, d.fsys.opts...)
+	if err != nil {
+		return &fs.PathError{Op: "readdir", Path: d.name, Err: err}
+	}
+
+	var count uint64
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err == nil {
+			err = statusError(res.Status)
+		}
+		if err != nil {
+			return &fs.PathError{Op: "readdir", Path: d.name, Err: err}
+		}
+		for _, e := range res.Entries {
+			count++
+			if name := string(e.Name); name != "." && name != ".." {
+				d.pending = append(d.pending, &dirEntry{fsys: d.fsys, dir: d.name, name: name, mode: e.Mode})
+			}
+		}
+	}
+	d.offset += count
+	d.eof = count == 0
+	return nil
+}
+
+func (d *dir) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return &fs.PathError{Op: "close", Path: d.name, Err: fs.ErrClosed}
+	}
+	d.closed = true
+	defer d.release()
+
+	if _, err := d.fsys.client.ReleaseDir(d.fsys.ctx, &pb.ReleaseRequest{Header: d.fsys.header(d.node), Fh: d.fh}, d.fsys.opts...); err != nil {
+		return &fs.PathError{Op: "close", Path: d.name, Err: err}
+	}
+	return nil
+}
+
+func sortEntries(entries []fs.DirEntry) {
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+}
+
+// toFileMode converts the mode of a stat to a fs.FileMode.
+func toFileMode(mode uint32) fs.FileMode {
+	m := fs.FileMode(mode & 0777)
+	switch mode & syscall.S_IFMT {
+	case syscall.S_IFDIR:
+		m |= fs.ModeDir
+	case syscall.S_IFLNK:
+		m |= fs.ModeSymlink
+	case syscall.S_IFIFO:
+		m |= fs.ModeNamedPipe
+	case syscall.S_IFSOCK:
+		m |= fs.ModeSocket
+	case syscall.S_IFCHR:
+		m |= fs.ModeDevice | fs.ModeCharDevice
+	case syscall.S_IFBLK:
+		m |= fs.ModeDevice
+	}
+	if mode&syscall.S_ISUID != 0 {
+		m |= fs.ModeSetuid
+	}
+	if mode&syscall.S_ISGID != 0 {
+		m |= fs.ModeSetgid
+	}
+	if mode&syscall.S_ISVTX != 0 {
+		m |= fs.ModeSticky
+	}
+	return m
+}
+
+// dirEntry is an entry of a directory listing, which only has the type
+// of the entry, Info looks it up.
+type dirEntry struct {
+	fsys *FS
+	dir  string
+	name string
+	mode uint32
+}
+
+func (e *dirEntry) Name() string      { return e.name }
+func (e *dirEntry) IsDir() bool       { return e.mode&syscall.S_IFMT == syscall.S_IFDIR }
+func (e *dirEntry) Type() fs.FileMode { return toFileMode(e.mode).Type() }
+
+func (e *dirEntry) Info() (fs.FileInfo, error) {
+	return e.fsys.Stat(path.Join(e.dir, e.name))
+}
+
+func (e *dirEntry) String() string {
+	return fs.FormatDirEntry(e)
+}
+
+// fileInfo is the attributes of a file.
+type fileInfo struct {
+	name string
+	attr *pb.Attr
+}
+
+func newFileInfo(name string, attr *pb.Attr) fs.FileInfo {
+	return &fileInfo{name: path.Base(name), attr: attr}
+}
+
+func (fi *fileInfo) Name() string      { return fi.name }
+func (fi *fileInfo) Size() int64       { return int64(fi.attr.GetSize()) }
+func (fi *fileInfo) Mode() fs.FileMode { return toFileMode(fi.attr.GetMode()) }
+func (fi *fileInfo) IsDir() bool       { return fi.Mode().IsDir() }
+func (fi *fileInfo) Sys() interface{}  { return fi.attr }
+func (fi *fileInfo) String() string    { return fs.FormatFileInfo(fi) }
+func (fi *fileInfo) ModTime() time.Time {
+	return time.Unix(int64(fi.attr.GetMtime()), int64(fi.attr.GetMtimensec()))
+}
@@ -0,0 +1,266 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpcfs
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"sync"
+	"syscall"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+// File is an open regular file.
+type File struct {
+	fsys *FS
+	name string
+	node uint64
+	fh   uint64
+	attr *pb.Attr
+
+	// release forgets the nodes looked up to open the file.
+	release func()
+
+	mu     sync.Mutex
+	offset int64
+	closed bool
+}
+
+var (
+	_ fs.File       = (*File)(nil)
+	_ io.ReaderAt   = (*File)(nil)
+	_ io.Seeker     = (*File)(nil)
+	_ io.WriterAt   = (*File)(nil)
+	_ io.ReaderFrom = (*File)(nil)
+)
+
+// Name returns the path the file was opened with.
+func (f *File) Name() string {
+	return f.name
+}
+
+// Stat returns the current attributes of the file.
+func (f *File) Stat() (fs.FileInfo, error) {
+	res, err := f.fsys.client.GetAttr(f.fsys.ctx, &pb.GetAttrRequest{Header: f.fsys.header(f.node)}, f.fsys.opts...)
+	if err == nil {
+		err = statusError(res.Status)
+	}
+	if err != nil {
+		return nil, f.error("stat", err)
+	}
+	return newFileInfo(f.name, res.AttrOut.GetAttr()), nil
+}
+
+// Read reads from the current offset.
+func (f *File) Read(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n, err := f.ReadAt(p, f.offset)
+	f.offset += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+// ReadAt reads len(p) bytes at off, it fails with io.EOF when the file
+// ends first.
+func (f *File) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, f.error("read", fs.ErrInvalid)
+	}
+	var total int
+	for total < len(p) {
+		size := len(p) - total
+		if size > maxIO {
+			size = maxIO
+		}
+		n, err := f.read(p[total:total+size], off+int64(total))
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n < size {
+			return total, io.EOF
+		}
+	}
+	return total, nil
+}
+
+func (f *File) read(p []byte, off int64) (int, error) {
+	stream, err := f.fsys.client.Read(f.fsys.ctx, &pb.ReadRequest{ReadIn: &pb.ReadIn{
+		Header: f.fsys.header(f.node),
+		Fh:     f.fh,
+		Offset: uint64(off),
+		Size:   uint32(len(p)),
+	}}, f.fsys.opts...)
+	if err != nil {
+		return 0, f.error("read", err)
+	}
+	var n int
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return n, nil
+		}
+		if err == nil {
+			err = statusError(res.Status)
+		}
+		if err != nil {
+			return n, f.error("read", err)
+		}
+		n += copy(p[n:], res.Buffer)
+	}
+}
+
+// Seek sets the offset of the next Read or Write.
+func (f *File) Seek(offset int64, whence int) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		info, err := f.Stat()
+		if err != nil {
+			return 0, err
+		}
+		offset += info.Size()
+	default:
+		return 0, f.error("seek", fs.ErrInvalid)
+	}
+	if offset < 0 {
+		return 0, f.error("seek", fs.ErrInvalid)
+	}
+	f.offset = offset
+	return offset, nil
+}
+
+// Write writes at the current offset.
+func (f *File) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n, err := f.WriteAt(p, f.offset)
+	f.offset += int64(n)
+	return n, err
+}
+
+// ReadFrom writes what r reads at the current offset, in writes of the
+// largest size the server accepts.
+func (f *File) ReadFrom(r io.Reader) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	buf := make([]byte, maxIO)
+	var total int64
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			written, werr := f.WriteAt(buf[:n], f.offset)
+			f.offset += int64(written)
+			total += int64(written)
+			if werr != nil {
+				return total, werr
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// WriteAt writes p at off.
+func (f *File) WriteAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, f.error("write", fs.ErrInvalid)
+	}
+	var total int
+	for total < len(p) {
+		data := p[total:]
+		if len(data) > maxIO {
+			data = data[:maxIO]
+		}
+		res, err := f.fsys.client.Write(f.fsys.ctx, &pb.WriteRequest{
+			Header: f.fsys.header(f.node),
+			Fh:     f.fh,
+			Offset: uint64(off) + uint64(total),
+			Data:   data,
+			Size:   uint32(len(data)),
+		}, f.fsys.opts...)
+		if err == nil {
+			err = statusError(res.Status)
+		}
+		if err != nil {
+			return total, f.error("write", err)
+		}
+		if res.Written == 0 {
+			return total, f.error("write", io.ErrShortWrite)
+		}
+		total += int(res.Written)
+	}
+	return total, nil
+}
+
+// Close flushes and releases the file, dropping its locks.
+func (f *File) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return f.error("close", fs.ErrClosed)
+	}
+	f.closed = true
+	defer f.release()
+
+	// the locks of the file are owned by its handle, see setLk
+	res, err := f.fsys.client.Flush(f.fsys.ctx, &pb.FlushRequest{Header: f.fsys.header(f.node), Fh: f.fh, LockOwner: f.fh}, f.fsys.opts...)
+	if err == nil {
+		err = statusError(res.Status)
+	}
+	if _, rerr := f.fsys.client.Release(f.fsys.ctx, &pb.ReleaseRequest{Header: f.fsys.header(f.node), Fh: f.fh, LockOwner: f.fh}, f.fsys.opts...); err == nil {
+		err = rerr
+	}
+	if err != nil {
+		return f.error("close", err)
+	}
+	return nil
+}
+
+func (f *File) error(op string, err error) error {
+	if errors.Is(err, io.EOF) {
+		return err
+	}
+	return &fs.PathError{Op: op, Path: f.name, Err: err}
+}
+
+// errno returns the errno behind err, 0 when there is none.
+func errno(err error) syscall.Errno {
+	var e syscall.Errno
+	if errors.As(err, &e) {
+		return e
+	}
+	return 0
+}
@@ -0,0 +1,280 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package grpcfs reads and writes the file systems served by fuse2grpc
+// without mounting them, for tools which cannot use FUSE. FS implements
+// io/fs.FS along with fs.ReadDirFS, fs.StatFS and fs.ReadFileFS, and
+// offers helpers to change the file system.
+//
+// Paths are resolved with one Lookup per element and symbolic links
+// are not followed.
+package grpcfs
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+const (
+	// maxIO is the largest read or write sent in one request, below
+	// the default gRPC message size limit.
+	maxIO = 1 << 20
+
+	// dirBufferSize is the size of the directory listings asked for.
+	dirBufferSize = 64 << 10
+)
+
+// FS is a file system served by fuse2grpc.
+type FS struct {
+	client pb.RawFileSystemClient
+	opts   []grpc.CallOption
+
+	// ctx carries the client id and the export to the server.
+	ctx    context.Context
+	export string
+	caller *pb.Caller
+}
+
+var (
+	_ fs.ReadDirFS  = (*FS)(nil)
+	_ fs.StatFS     = (*FS)(nil)
+	_ fs.ReadFileFS = (*FS)(nil)
+)
+
+// Option configures an FS.
+type Option func(*FS)
+
+// WithContext makes the requests with ctx, which bounds the lifetime of
+// the FS.
+func WithContext(ctx context.Context) Option {
+	return func(fsys *FS) {
+		fsys.ctx = ctx
+	}
+}
+
+// WithExport uses the export name of a server serving several file
+// systems instead of its default one.
+func WithExport(name string) Option {
+	return func(fsys *FS) {
+		fsys.export = name
+	}
+}
+
+// WithCaller makes the requests as uid and gid, by default the ids of
+// the process are used.
+func WithCaller(uid, gid uint32) Option {
+	return func(fsys *FS) {
+		fsys.caller.Owner = &pb.Owner{Uid: uid, Gid: gid}
+	}
+}
+
+// WithCallOptions adds options to every call.
+func WithCallOptions(opts ...grpc.CallOption) Option {
+	return func(fsys *FS) {
+		fsys.opts = append(fsys.opts, opts...)
+	}
+}
+
+// New returns the file system client serves.
+func New(client pb.RawFileSystemClient, opts ...Option) *FS {
+	fsys := &FS{
+		client: client,
+		ctx:    context.Background(),
+		caller: &pb.Caller{
+			Owner: &pb.Owner{Uid: uint32(os.Getuid()), Gid: uint32(os.Getgid())},
+			Pid:   uint32(os.Getpid()),
+		},
+	}
+	for _, opt := range opts {
+		opt(fsys)
+	}
+	fsys.ctx = metadata.AppendToOutgoingContext(fsys.ctx, utils.ClientIDKey, utils.NewClientID())
+	if fsys.export != "" {
+		fsys.ctx = metadata.AppendToOutgoingContext(fsys.ctx, utils.ExportKey, fsys.export)
+	}
+	return fsys
+}
+
+// WithRequestContext returns a copy of fsys making its requests with
+// ctx, so they are canceled along with ctx. Unlike the WithContext
+// option, it keeps the client id and export of fsys.
+func (fsys *FS) WithRequestContext(ctx context.Context) *FS {
+	md, _ := metadata.FromOutgoingContext(fsys.ctx)
+	c := *fsys
+	c.ctx = metadata.NewOutgoingContext(ctx, md)
+	return &c
+}
+
+func (fsys *FS) header(node uint64) *pb.InHeader {
+	return &pb.InHeader{NodeId: node, Caller: fsys.caller}
+}
+
+// statusError returns the errno of a failed request, nil on success.
+func statusError(st *pb.Status) error {
+	if code := st.GetCode(); code != 0 {
+		return syscall.Errno(code)
+	}
+	return nil
+}
+
+// forget drops the lookups of nodes, which the server counts.
+func (fsys *FS) forget(nodes []uint64) {
+	for _, node := range nodes {
+		fsys.client.Forget(fsys.ctx, &pb.ForgetRequest{Nodeid: node, Nlookup: 1}, fsys.opts...)
+	}
+}
+
+// lookup resolves name, release forgets the nodes looked up on the way.
+func (fsys *FS) lookup(op, name string) (entry *pb.EntryOut, release func(), err error) {
+	if !fs.ValidPath(name) {
+		return nil, nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	if name == "." {
+		res, err := fsys.client.GetAttr(fsys.ctx, &pb.GetAttrRequest{Header: fsys.header(fuse.FUSE_ROOT_ID)}, fsys.opts...)
+		if err == nil {
+			err = statusError(res.Status)
+		}
+		if err != nil {
+			return nil, nil, &fs.PathError{Op: op, Path: name, Err: err}
+		}
+		return &pb.EntryOut{NodeId: fuse.FUSE_ROOT_ID, Attr: res.AttrOut.GetAttr()}, func() {}, nil
+	}
+
+	var nodes []uint64
+	release = func() { fsys.forget(nodes) }
+	node := uint64(fuse.FUSE_ROOT_ID)
+	for _, part := range strings.Split(name, "/") {
+		res, err := fsys.client.Lookup(fsys.ctx, &pb.LookupRequest{Header: fsys.header(node), Name: part}, fsys.opts...)
+		if err == nil {
+			err = statusError(res.Status)
+		}
+		if err != nil {
+			release()
+			return nil, nil, &fs.PathError{Op: op, Path: name, Err: err}
+		}
+		entry = res.EntryOut
+		node = entry.NodeId
+		nodes = append(nodes, node)
+	}
+	return entry, release, nil
+}
+
+// Open opens name for reading.
+func (fsys *FS) Open(name string) (fs.File, error) {
+	return fsys.openFile("open", name, os.O_RDONLY)
+}
+
+// Stat returns the attributes of name.
+func (fsys *FS) Stat(name string) (fs.FileInfo, error) {
+	entry, release, err := fsys.lookup("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	release()
+	return newFileInfo(name, entry.Attr), nil
+}
+
+// ReadDir returns the entries of the directory name sorted by name.
+func (fsys *FS) ReadDir(name string) ([]fs.DirEntry, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	d, ok := f.(*dir)
+	if !ok {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: syscall.ENOTDIR}
+	}
+	entries, err := d.ReadDir(-1)
+	sortEntries(entries)
+	return entries, err
+}
+
+// ReadFile returns the content of name.
+func (fsys *FS) ReadFile(name string) ([]byte, error) {
+	f, err := fsys.openFile("open", name, os.O_RDONLY)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	file, ok := f.(*File)
+	if !ok {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: syscall.EISDIR}
+	}
+	data := make([]byte, 0, file.attr.GetSize())
+	for {
+		if len(data) == cap(data) {
+			data = append(data, 0)[:len(data)]
+		}
+		n, err := file.Read(data[len(data):cap(data)])
+		data = data[:len(data)+n]
+		if err == io.EOF {
+			return data, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// openFile opens the file or directory name.
+func (fsys *FS) openFile(op, name string, flags int) (fs.File, error) {
+	entry, release, err := fsys.lookup(op, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.Attr.GetMode()&syscall.S_IFMT == syscall.S_IFDIR {
+		res, err := fsys.client.OpenDir(fsys.ctx, &pb.OpenDirRequest{OpenIn: &pb.OpenIn{
+			Header: fsys.header(entry.NodeId),
+			Flags:  uint32(flags),
+		}}, fsys.opts...)
+		if err == nil {
+			err = statusError(res.Status)
+		}
+		if err != nil {
+			release()
+			return nil, &fs.PathError{Op: op, Path: name, Err: err}
+		}
+		return &dir{fsys: fsys, name: name, node: entry.NodeId, fh: res.OpenOut.GetFh(), attr: entry.Attr, release: release}, nil
+	}
+
+	res, err := fsys.client.Open(fsys.ctx, &pb.OpenRequest{OpenIn: &pb.OpenIn{
+		Header: fsys.header(entry.NodeId),
+		Flags:  uint32(flags),
+	}}, fsys.opts...)
+	if err == nil {
+		err = statusError(res.Status)
+	}
+	if err != nil {
+		release()
+		return nil, &fs.PathError{Op: op, Path: name, Err: err}
+	}
+	return &File{fsys: fsys, name: name, node: entry.NodeId, fh: res.OpenOut.GetFh(), attr: entry.Attr, release: release}, nil
+}
@@ -0,0 +1,119 @@
+package grpcfs_test
+
+import (
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"testing/fstest"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/chiyutianyi/grpcfuse/fuse2grpc"
+	"github.com/chiyutianyi/grpcfuse/grpcfs"
+	"github.com/chiyutianyi/grpcfuse/memfs"
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+func newFS(t *testing.T) *grpcfs.FS {
+	socket := filepath.Join(t.TempDir(), "grpcfs.sock")
+	l, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	srv := fuse2grpc.NewServer(memfs.New(memfs.Options{
+		Owner: fuse.Owner{Uid: uint32(os.Getuid()), Gid: uint32(os.Getgid())},
+	}))
+	s := grpc.NewServer(grpc.StatsHandler(srv.StatsHandler()))
+	pb.RegisterRawFileSystemServer(s, srv)
+	go s.Serve(l)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return grpcfs.New(pb.NewRawFileSystemClient(conn))
+}
+
+func TestFS(t *testing.T) {
+	fsys := newFS(t)
+
+	require.NoError(t, fsys.MkdirAll("a/b", 0755))
+	require.NoError(t, fsys.Mkdir("empty", 0755))
+	require.NoError(t, fsys.WriteFile("hello.txt", []byte("hello world"), 0644))
+	require.NoError(t, fsys.WriteFile("a/b/c.txt", []byte("c"), 0600))
+	require.NoError(t, fsys.WriteFile("a/tmp", []byte("tmp"), 0644))
+	require.NoError(t, fsys.Rename("a/tmp", "a/moved"))
+
+	require.NoError(t, fstest.TestFS(fsys, "hello.txt", "a/b/c.txt", "a/moved", "empty"))
+
+	data, err := fsys.ReadFile("hello.txt")
+	require.NoError(t, err)
+	require.Equal(t, "hello world", string(data))
+
+	info, err := fsys.Stat("a/b/c.txt")
+	require.NoError(t, err)
+	require.Equal(t, "c.txt", info.Name())
+	require.Equal(t, fs.FileMode(0600), info.Mode())
+
+	entries, err := fsys.ReadDir("a")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "b", entries[0].Name())
+	require.True(t, entries[0].IsDir())
+	require.Equal(t, "moved", entries[1].Name())
+
+	_, err = fsys.Stat("a/tmp")
+	require.ErrorIs(t, err, fs.ErrNotExist)
+	_, err = fsys.Open("../escape")
+	require.ErrorIs(t, err, fs.ErrInvalid)
+	_, err = fsys.ReadDir("hello.txt")
+	require.ErrorIs(t, err, syscall.ENOTDIR)
+
+	require.ErrorIs(t, fsys.Remove("a"), syscall.ENOTEMPTY)
+	require.NoError(t, fsys.Remove("a/b/c.txt"))
+	require.NoError(t, fsys.Remove("a/b"))
+	_, err = fsys.Stat("a/b")
+	require.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestFile(t *testing.T) {
+	fsys := newFS(t)
+
+	f, err := fsys.Create("file", 0644)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("0123456789"))
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte("ab"), 12)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.ErrorIs(t, f.Close(), fs.ErrClosed)
+
+	r, err := fsys.Open("file")
+	require.NoError(t, err)
+	defer r.Close()
+	file := r.(*grpcfs.File)
+
+	buf := make([]byte, 4)
+	n, err := file.ReadAt(buf, 3)
+	require.NoError(t, err)
+	require.Equal(t, "3456", string(buf[:n]))
+
+	off, err := file.Seek(-3, io.SeekEnd)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), off)
+	data, err := io.ReadAll(file)
+	require.NoError(t, err)
+	require.Equal(t, "\x00ab", string(data))
+
+	// A second Create truncates the file.
+	require.NoError(t, fsys.WriteFile("file", []byte("new"), 0644))
+	data, err = fsys.ReadFile("file")
+	require.NoError(t, err)
+	require.Equal(t, "new", string(data))
+}
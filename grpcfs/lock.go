@@ -0,0 +1,61 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpcfs
+
+import (
+	"math"
+	"syscall"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+// TryLock takes a POSIX record lock on the whole file, shared or
+// exclusive, without waiting. It fails with EAGAIN if another owner
+// holds a conflicting lock. The lock is released by Unlock or Close.
+func (f *File) TryLock(exclusive bool) error {
+	typ := uint32(syscall.F_RDLCK)
+	if exclusive {
+		typ = syscall.F_WRLCK
+	}
+	return f.setLk("lock", typ)
+}
+
+// Unlock releases the lock taken by TryLock.
+func (f *File) Unlock() error {
+	return f.setLk("unlock", syscall.F_UNLCK)
+}
+
+func (f *File) setLk(op string, typ uint32) error {
+	res, err := f.fsys.client.SetLk(f.fsys.ctx, &pb.LkRequest{
+		Header: f.fsys.header(f.node),
+		Fh:     f.fh,
+		Owner:  f.fh,
+		Lk: &pb.FileLock{
+			Start: 0,
+			End:   math.MaxInt64,
+			Type:  typ,
+			Pid:   f.fsys.caller.Pid,
+		},
+	}, f.fsys.opts...)
+	if err == nil {
+		err = statusError(res.Status)
+	}
+	if err != nil {
+		return f.error(op, err)
+	}
+	return nil
+}
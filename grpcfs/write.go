@@ -0,0 +1,239 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpcfs
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"syscall"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+// parent resolves the directory of name, it returns the node of the
+// directory and the base of name.
+func (fsys *FS) parent(op, name string) (node uint64, base string, release func(), err error) {
+	if !fs.ValidPath(name) || name == "." {
+		return 0, "", nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	entry, release, err := fsys.lookup(op, path.Dir(name))
+	if err != nil {
+		return 0, "", nil, &fs.PathError{Op: op, Path: name, Err: errors.Unwrap(err)}
+	}
+	return entry.NodeId, path.Base(name), release, nil
+}
+
+// Create creates or truncates the file name and opens it for reading
+// and writing.
+func (fsys *FS) Create(name string, perm fs.FileMode) (*File, error) {
+	f, err := fsys.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return nil, err
+	}
+	if file, ok := f.(*File); ok {
+		return file, nil
+	}
+	f.Close()
+	return nil, &fs.PathError{Op: "create", Path: name, Err: syscall.EISDIR}
+}
+
+// OpenFile opens name with the flags of os.OpenFile, creating it with
+// perm if O_CREATE is given. Directories can only be opened for reading.
+func (fsys *FS) OpenFile(name string, flag int, perm fs.FileMode) (fs.File, error) {
+	if flag&os.O_CREATE == 0 {
+		return fsys.openFile("open", name, flag)
+	}
+
+	node, base, release, err := fsys.parent("open", name)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	res, err := fsys.client.Create(fsys.ctx, &pb.CreateRequest{
+		Header: fsys.header(node),
+		Name:   base,
+		Flags:  uint32(flag),
+		Mode:   uint32(perm.Perm()) | syscall.S_IFREG,
+	}, fsys.opts...)
+	if err == nil {
+		err = statusError(res.Status)
+	}
+	if err == syscall.EEXIST && flag&os.O_EXCL == 0 {
+		// Some file systems want the existing file opened instead.
+		return fsys.openFile("open", name, flag&^os.O_CREATE)
+	}
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	entry := res.EntryOut
+	return &File{
+		fsys:    fsys,
+		name:    name,
+		node:    entry.NodeId,
+		fh:      res.OpenOut.GetFh(),
+		attr:    entry.Attr,
+		release: func() { fsys.forget([]uint64{entry.NodeId}) },
+	}, nil
+}
+
+// WriteFile writes data to the file name, creating it with perm if
+// needed.
+func (fsys *FS) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	f, err := fsys.Create(name, perm)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Mkdir creates the directory name.
+func (fsys *FS) Mkdir(name string, perm fs.FileMode) error {
+	node, base, release, err := fsys.parent("mkdir", name)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	res, err := fsys.client.Mkdir(fsys.ctx, &pb.MkdirRequest{
+		Header: fsys.header(node),
+		Name:   base,
+		Mode:   uint32(perm.Perm()),
+	}, fsys.opts...)
+	if err == nil {
+		err = statusError(res.Status)
+	}
+	if err != nil {
+		return &fs.PathError{Op: "mkdir", Path: name, Err: err}
+	}
+	fsys.forget([]uint64{res.EntryOut.GetNodeId()})
+	return nil
+}
+
+// MkdirAll creates the directory name along with its missing parents.
+func (fsys *FS) MkdirAll(name string, perm fs.FileMode) error {
+	if info, err := fsys.Stat(name); err == nil {
+		if info.IsDir() {
+			return nil
+		}
+		return &fs.PathError{Op: "mkdir", Path: name, Err: syscall.ENOTDIR}
+	}
+	if dir := path.Dir(name); dir != "." {
+		if err := fsys.MkdirAll(dir, perm); err != nil {
+			return err
+		}
+	}
+	if err := fsys.Mkdir(name, perm); err != nil && errno(err) != syscall.EEXIST {
+		return err
+	}
+	return nil
+}
+
+// Rename renames oldname to newname, replacing newname if it exists.
+func (fsys *FS) Rename(oldname, newname string) error {
+	oldNode, oldBase, releaseOld, err := fsys.parent("rename", oldname)
+	if err != nil {
+		return err
+	}
+	defer releaseOld()
+	newNode, newBase, releaseNew, err := fsys.parent("rename", newname)
+	if err != nil {
+		return err
+	}
+	defer releaseNew()
+
+	res, err := fsys.client.Rename(fsys.ctx, &pb.RenameRequest{
+		Header:  fsys.header(oldNode),
+		OldName: oldBase,
+		NewName: newBase,
+		Newdir:  newNode,
+	}, fsys.opts...)
+	if err == nil {
+		err = statusError(res.Status)
+	}
+	if err != nil {
+		return &os.LinkError{Op: "rename", Old: oldname, New: newname, Err: err}
+	}
+	return nil
+}
+
+// RemoveAll removes name and everything it contains, it succeeds if name
+// does not exist.
+func (fsys *FS) RemoveAll(name string) error {
+	info, err := fsys.Stat(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		entries, err := fsys.ReadDir(name)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if err := fsys.RemoveAll(path.Join(name, e.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	if err := fsys.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Remove removes the file or empty directory name.
+func (fsys *FS) Remove(name string) error {
+	info, err := fsys.Stat(name)
+	if err != nil {
+		return &fs.PathError{Op: "remove", Path: name, Err: errors.Unwrap(err)}
+	}
+	node, base, release, err := fsys.parent("remove", name)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	var st *pb.Status
+	if info.IsDir() {
+		var res *pb.RmdirResponse
+		if res, err = fsys.client.Rmdir(fsys.ctx, &pb.RmdirRequest{Header: fsys.header(node), Name: base}, fsys.opts...); err == nil {
+			st = res.Status
+		}
+	} else {
+		var res *pb.UnlinkResponse
+		if res, err = fsys.client.Unlink(fsys.ctx, &pb.UnlinkRequest{Header: fsys.header(node), Name: base}, fsys.opts...); err == nil {
+			st = res.Status
+		}
+	}
+	if err == nil {
+		err = statusError(st)
+	}
+	if err != nil {
+		return &fs.PathError{Op: "remove", Path: name, Err: err}
+	}
+	return nil
+}
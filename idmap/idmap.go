@@ -0,0 +1,211 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package idmap translates the uids and gids of the clients of a
+// fuse2grpc server to the ids of the server and back.
+package idmap
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+// Squash selects which callers are replaced by the anonymous user, like
+// the root_squash and all_squash options of NFS.
+type Squash int
+
+const (
+	// NoSquash keeps all callers.
+	NoSquash Squash = iota
+	// RootSquash replaces root.
+	RootSquash
+	// AllSquash replaces every caller.
+	AllSquash
+)
+
+// ParseSquash parses "none", "root" or "all".
+func ParseSquash(s string) (Squash, error) {
+	switch s {
+	case "", "none":
+		return NoSquash, nil
+	case "root":
+		return RootSquash, nil
+	case "all":
+		return AllSquash, nil
+	}
+	return NoSquash, fmt.Errorf("unknown squash mode %q", s)
+}
+
+// DefaultAnonID is the id of nobody.
+const DefaultAnonID = 65534
+
+// Mapper maps client ids to server ids. Ids without an explicit mapping
+// are the same on both ends.
+type Mapper struct {
+	// Squash selects the callers replaced by AnonUID and AnonGID.
+	Squash  Squash
+	AnonUID uint32
+	AnonGID uint32
+
+	uids, gids   map[uint32]uint32 // client -> server
+	ruids, rgids map[uint32]uint32 // server -> client
+}
+
+// NewMapper returns a Mapper without any explicit mapping.
+func NewMapper() *Mapper {
+	return &Mapper{
+		AnonUID: DefaultAnonID,
+		AnonGID: DefaultAnonID,
+		uids:    make(map[uint32]uint32),
+		gids:    make(map[uint32]uint32),
+		ruids:   make(map[uint32]uint32),
+		rgids:   make(map[uint32]uint32),
+	}
+}
+
+// AddUID maps the client uid to the server uid. If several client uids
+// map to the same server uid, the first one is used for the way back.
+func (m *Mapper) AddUID(client, server uint32) {
+	m.uids[client] = server
+	if _, ok := m.ruids[server]; !ok {
+		m.ruids[server] = client
+	}
+}
+
+// AddGID is AddUID for gids.
+func (m *Mapper) AddGID(client, server uint32) {
+	m.gids[client] = server
+	if _, ok := m.rgids[server]; !ok {
+		m.rgids[server] = client
+	}
+}
+
+func lookup(ids map[uint32]uint32, id uint32) uint32 {
+	if mapped, ok := ids[id]; ok {
+		return mapped
+	}
+	return id
+}
+
+// MapCaller maps the owner of a request's caller to the server, applying
+// the squash mode.
+func (m *Mapper) MapCaller(o *pb.Owner) {
+	if o == nil {
+		return
+	}
+	if m.Squash == AllSquash {
+		o.Uid, o.Gid = m.AnonUID, m.AnonGID
+		return
+	}
+	if m.Squash == RootSquash {
+		if o.Uid == 0 {
+			o.Uid = m.AnonUID
+		} else {
+			o.Uid = lookup(m.uids, o.Uid)
+		}
+		if o.Gid == 0 {
+			o.Gid = m.AnonGID
+		} else {
+			o.Gid = lookup(m.gids, o.Gid)
+		}
+		return
+	}
+	o.Uid = lookup(m.uids, o.Uid)
+	o.Gid = lookup(m.gids, o.Gid)
+}
+
+// MapOwner maps the ids of o from the client to the server, e.g. the new
+// owner of a chown.
+func (m *Mapper) MapOwner(o *pb.Owner) {
+	if o == nil {
+		return
+	}
+	o.Uid = lookup(m.uids, o.Uid)
+	o.Gid = lookup(m.gids, o.Gid)
+}
+
+// UnmapOwner maps the ids of o from the server back to the client.
+func (m *Mapper) UnmapOwner(o *pb.Owner) {
+	if o == nil {
+		return
+	}
+	o.Uid = lookup(m.ruids, o.Uid)
+	o.Gid = lookup(m.rgids, o.Gid)
+}
+
+// ParseMap reads a map file. Every line maps one client id to a server
+// id, empty lines and lines starting with # are skipped:
+//
+//	# client server
+//	uid 1000 501
+//	gid 1000 20
+func ParseMap(r io.Reader) (*Mapper, error) {
+	m := NewMapper()
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("line %d: want \"uid|gid <client> <server>\"", line)
+		}
+		client, err := strconv.ParseUint(fields[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		server, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		switch fields[0] {
+		case "uid":
+			m.AddUID(uint32(client), uint32(server))
+		case "gid":
+			m.AddGID(uint32(client), uint32(server))
+		default:
+			return nil, fmt.Errorf("line %d: unknown kind %q", line, fields[0])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LoadMap reads the map file name.
+func LoadMap(name string) (*Mapper, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m, err := ParseMap(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return m, nil
+}
@@ -0,0 +1,96 @@
+package idmap_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/chiyutianyi/grpcfuse/idmap"
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+const testMap = `
+# client server
+uid 1000 501
+gid 1000 20
+uid 1001 501
+`
+
+func requireOwner(t *testing.T, uid, gid uint32, o *pb.Owner) {
+	require.Equal(t, uid, o.Uid)
+	require.Equal(t, gid, o.Gid)
+}
+
+func TestParseMap(t *testing.T) {
+	m, err := idmap.ParseMap(strings.NewReader(testMap))
+	require.NoError(t, err)
+
+	o := &pb.Owner{Uid: 1001, Gid: 1000}
+	m.MapOwner(o)
+	requireOwner(t, 501, 20, o)
+
+	// the first mapping wins on the way back
+	m.UnmapOwner(o)
+	requireOwner(t, 1000, 1000, o)
+
+	// unmapped ids are kept
+	o = &pb.Owner{Uid: 7, Gid: 8}
+	m.MapOwner(o)
+	requireOwner(t, 7, 8, o)
+
+	for _, bad := range []string{"uid 1", "user 1 2", "uid a 2", "gid 1 -2"} {
+		_, err = idmap.ParseMap(strings.NewReader(bad))
+		require.Error(t, err, bad)
+	}
+}
+
+func TestSquash(t *testing.T) {
+	m, err := idmap.ParseMap(strings.NewReader(testMap))
+	require.NoError(t, err)
+
+	testcases := []struct {
+		squash idmap.Squash
+		in     *pb.Owner
+		out    *pb.Owner
+	}{
+		{idmap.NoSquash, &pb.Owner{Uid: 0, Gid: 0}, &pb.Owner{Uid: 0, Gid: 0}},
+		{idmap.NoSquash, &pb.Owner{Uid: 1000, Gid: 1000}, &pb.Owner{Uid: 501, Gid: 20}},
+		{idmap.RootSquash, &pb.Owner{Uid: 0, Gid: 0}, &pb.Owner{Uid: 65534, Gid: 65534}},
+		{idmap.RootSquash, &pb.Owner{Uid: 1000, Gid: 0}, &pb.Owner{Uid: 501, Gid: 65534}},
+		{idmap.AllSquash, &pb.Owner{Uid: 1000, Gid: 1000}, &pb.Owner{Uid: 65534, Gid: 65534}},
+	}
+	for _, testcase := range testcases {
+		m.Squash = testcase.squash
+		m.MapCaller(testcase.in)
+		require.Equal(t, testcase.out.Uid, testcase.in.Uid)
+		require.Equal(t, testcase.out.Gid, testcase.in.Gid)
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	m, err := idmap.ParseMap(strings.NewReader(testMap))
+	require.NoError(t, err)
+	m.Squash = idmap.RootSquash
+
+	req := &pb.SetAttrRequest{
+		Header: &pb.InHeader{Caller: &pb.Caller{Owner: &pb.Owner{Uid: 0, Gid: 0}}},
+		// chown to 1000:1000
+		Owner: &pb.Owner{Uid: 1000, Gid: 1000},
+	}
+	handler := func(ctx context.Context, in interface{}) (interface{}, error) {
+		req := in.(*pb.SetAttrRequest)
+		requireOwner(t, 65534, 65534, req.Header.Caller.Owner)
+		requireOwner(t, 501, 20, req.Owner)
+		return &pb.SetAttrResponse{
+			Status:  &pb.Status{Code: 0},
+			AttrOut: &pb.AttrOut{Attr: &pb.Attr{Owner: &pb.Owner{Uid: 501, Gid: 20}}},
+		}, nil
+	}
+
+	res, err := idmap.UnaryServerInterceptor(m)(context.Background(), req, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	requireOwner(t, 1000, 1000, res.(*pb.SetAttrResponse).AttrOut.Attr.Owner)
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package idmap
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+var (
+	ownerName  = (&pb.Owner{}).ProtoReflect().Descriptor().FullName()
+	callerName = (&pb.Caller{}).ProtoReflect().Descriptor().FullName()
+)
+
+// walk calls fn for every pb.Owner in m, telling whether it is the owner
+// of a pb.Caller.
+func walk(m protoreflect.Message, fn func(o *pb.Owner, caller bool)) {
+	isCaller := m.Descriptor().FullName() == callerName
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() == nil {
+			return true
+		}
+		visit := func(v protoreflect.Value) {
+			child := v.Message()
+			if child.Descriptor().FullName() != ownerName {
+				walk(child, fn)
+				return
+			}
+			if o, ok := child.Interface().(*pb.Owner); ok {
+				fn(o, isCaller)
+			}
+		}
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				visit(list.Get(i))
+			}
+		case fd.IsMap():
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				if fd.MapValue().Message() != nil {
+					visit(v)
+				}
+				return true
+			})
+		default:
+			visit(v)
+		}
+		return true
+	})
+}
+
+// MapRequest maps the ids in req from the client to the server.
+func (m *Mapper) MapRequest(req interface{}) {
+	if msg, ok := req.(proto.Message); ok {
+		walk(msg.ProtoReflect(), func(o *pb.Owner, caller bool) {
+			if caller {
+				m.MapCaller(o)
+			} else {
+				m.MapOwner(o)
+			}
+		})
+	}
+}
+
+// UnmapResponse maps the ids in res from the server back to the client.
+func (m *Mapper) UnmapResponse(res interface{}) {
+	if msg, ok := res.(proto.Message); ok {
+		walk(msg.ProtoReflect(), func(o *pb.Owner, _ bool) {
+			m.UnmapOwner(o)
+		})
+	}
+}
+
+// UnaryServerInterceptor maps the ids of requests and responses with m.
+func UnaryServerInterceptor(m *Mapper) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		m.MapRequest(req)
+		res, err := handler(ctx, req)
+		if err == nil {
+			m.UnmapResponse(res)
+		}
+		return res, err
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	m *Mapper
+}
+
+func (s *serverStream) RecvMsg(msg interface{}) error {
+	if err := s.ServerStream.RecvMsg(msg); err != nil {
+		return err
+	}
+	s.m.MapRequest(msg)
+	return nil
+}
+
+func (s *serverStream) SendMsg(msg interface{}) error {
+	s.m.UnmapResponse(msg)
+	return s.ServerStream.SendMsg(msg)
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streams.
+func StreamServerInterceptor(m *Mapper) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, m: m})
+	}
+}
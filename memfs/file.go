@@ -0,0 +1,216 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memfs
+
+import (
+	"context"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+// handle is an open file. Locks taken through a handle are released
+// with it, so handles are compared and must not be zero-sized: pointers
+// to zero-sized values may all be equal.
+type handle struct {
+	_ byte
+}
+
+// Linux values of the fallocate modes.
+const (
+	fallocKeepSize  = 0x1
+	fallocPunchHole = 0x2
+)
+
+// Values of lseek whence for holes.
+const (
+	seekData = 3
+	seekHole = 4
+)
+
+var (
+	_ = (fs.NodeOpener)((*node)(nil))
+	_ = (fs.NodeReader)((*node)(nil))
+	_ = (fs.NodeWriter)((*node)(nil))
+	_ = (fs.NodeFlusher)((*node)(nil))
+	_ = (fs.NodeFsyncer)((*node)(nil))
+	_ = (fs.NodeReleaser)((*node)(nil))
+	_ = (fs.NodeAllocater)((*node)(nil))
+	_ = (fs.NodeCopyFileRanger)((*node)(nil))
+	_ = (fs.NodeLseeker)((*node)(nil))
+)
+
+func (n *node) Open(ctx context.Context, flags uint32) (fs.FileHandle, uint32, syscall.Errno) {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	if flags&syscall.O_TRUNC != 0 && n.isRegular() {
+		if errno := n.resize(0); errno != 0 {
+			return nil, 0, errno
+		}
+		n.touch()
+	}
+	return &handle{}, 0, 0
+}
+
+func (n *node) Read(ctx context.Context, f fs.FileHandle, dest []byte, off int64) (fuse.ReadResult, syscall.Errno) {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	if n.isDir() {
+		return nil, syscall.EISDIR
+	}
+	if off >= int64(len(n.data)) {
+		return fuse.ReadResultData(nil), 0
+	}
+	c := copy(dest, n.data[off:])
+	now := time.Now()
+	n.attr.SetTimes(&now, nil, nil)
+	return fuse.ReadResultData(dest[:c]), 0
+}
+
+// write copies data at off, growing the file when needed.
+func (n *node) write(data []byte, off int64) syscall.Errno {
+	if off < 0 {
+		return syscall.EINVAL
+	}
+	end, errno := fileEnd(uint64(off), uint64(len(data)))
+	if errno != 0 {
+		return errno
+	}
+	if end > uint64(len(n.data)) {
+		if errno := n.resize(end); errno != 0 {
+			return errno
+		}
+	}
+	copy(n.data[off:], data)
+	n.touch()
+	return 0
+}
+
+func (n *node) Write(ctx context.Context, f fs.FileHandle, data []byte, off int64) (uint32, syscall.Errno) {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	if !n.isRegular() {
+		return 0, syscall.EINVAL
+	}
+	if errno := n.write(data, off); errno != 0 {
+		return 0, errno
+	}
+	return uint32(len(data)), 0
+}
+
+func (n *node) Flush(ctx context.Context, f fs.FileHandle) syscall.Errno {
+	return 0
+}
+
+func (n *node) Fsync(ctx context.Context, f fs.FileHandle, flags uint32) syscall.Errno {
+	return 0
+}
+
+func (n *node) Release(ctx context.Context, f fs.FileHandle) syscall.Errno {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	n.releaseLocks(f)
+	return 0
+}
+
+func (n *node) Allocate(ctx context.Context, f fs.FileHandle, off uint64, size uint64, mode uint32) syscall.Errno {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	if !n.isRegular() {
+		return syscall.ENODEV
+	}
+	switch mode {
+	case 0:
+		end, errno := fileEnd(off, size)
+		if errno != 0 {
+			return errno
+		}
+		if end > uint64(len(n.data)) {
+			if errno := n.resize(end); errno != 0 {
+				return errno
+			}
+		}
+	case fallocKeepSize:
+		// Nothing is preallocated, sizes are all that count.
+		return 0
+	case fallocKeepSize | fallocPunchHole:
+		if off >= uint64(len(n.data)) {
+			return 0
+		}
+		end := uint64(len(n.data))
+		if size < end-off {
+			end = off + size
+		}
+		for i := off; i < end; i++ {
+			n.data[i] = 0
+		}
+	default:
+		return syscall.EOPNOTSUPP
+	}
+	n.touch()
+	return 0
+}
+
+func (n *node) CopyFileRange(ctx context.Context, fhIn fs.FileHandle, offIn uint64, out *fs.Inode, fhOut fs.FileHandle, offOut uint64, size uint64, flags uint64) (uint32, syscall.Errno) {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	dst, ok := out.Operations().(*node)
+	if !ok {
+		return 0, syscall.EXDEV
+	}
+	if !n.isRegular() || !dst.isRegular() {
+		return 0, syscall.EINVAL
+	}
+	if offIn >= uint64(len(n.data)) {
+		return 0, 0
+	}
+	end := uint64(len(n.data))
+	if size < end-offIn {
+		end = offIn + size
+	}
+	// Copy first as the ranges may overlap within the same file.
+	data := append([]byte(nil), n.data[offIn:end]...)
+	if errno := dst.write(data, int64(offOut)); errno != 0 {
+		return 0, errno
+	}
+	return uint32(len(data)), 0
+}
+
+func (n *node) Lseek(ctx context.Context, f fs.FileHandle, off uint64, whence uint32) (uint64, syscall.Errno) {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	// Files have no holes, the data runs up to the end of file.
+	if off >= uint64(len(n.data)) {
+		return 0, syscall.ENXIO
+	}
+	switch whence {
+	case seekData:
+		return off, 0
+	case seekHole:
+		return uint64(len(n.data)), 0
+	}
+	return 0, syscall.EINVAL
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memfs
+
+import (
+	"sync"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+
+	"github.com/chiyutianyi/grpcfuse/fuse2grpc"
+)
+
+// rawFileSystem is the fuse.RawFileSystem of a memfs served by
+// fuse2grpc, which tells it how the clients mount it.
+type rawFileSystem struct {
+	fuse.RawFileSystem
+
+	fsys     *fileSystem
+	initOnce sync.Once
+}
+
+// InitKernel reports the MaxWrite of the client as the block size of the
+// files, so that applications buffering by st_blksize write as much as
+// the client passes on at once. With several clients the smallest
+// MaxWrite wins.
+func (r *rawFileSystem) InitKernel(k *fuse2grpc.Kernel) {
+	r.initOnce.Do(func() {
+		r.RawFileSystem.Init(&fuse.Server{})
+	})
+
+	maxWrite := k.MountOptions().MaxWrite
+	if maxWrite <= 0 {
+		return
+	}
+	r.fsys.mu.Lock()
+	defer r.fsys.mu.Unlock()
+	if r.fsys.ioSize == 0 || uint32(maxWrite) < r.fsys.ioSize {
+		r.fsys.ioSize = uint32(maxWrite)
+	}
+}
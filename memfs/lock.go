@@ -0,0 +1,154 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memfs
+
+import (
+	"context"
+	"math"
+	"syscall"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+// lock is a POSIX record lock held by owner through the handle fh.
+type lock struct {
+	fuse.FileLock
+
+	fh    fs.FileHandle
+	owner uint64
+}
+
+var (
+	_ = (fs.NodeGetlker)((*node)(nil))
+	_ = (fs.NodeSetlker)((*node)(nil))
+	_ = (fs.NodeSetlkwer)((*node)(nil))
+)
+
+// conflict returns the first lock of another owner overlapping lk in an
+// incompatible way.
+func (n *node) conflict(owner uint64, lk *fuse.FileLock) *lock {
+	for i := range n.locks {
+		l := &n.locks[i]
+		if l.owner == owner || l.Start > lk.End || lk.Start > l.End {
+			continue
+		}
+		if l.Typ == syscall.F_WRLCK || lk.Typ == syscall.F_WRLCK {
+			return l
+		}
+	}
+	return nil
+}
+
+// unlock releases the range [start, end] held by owner, splitting the
+// locks crossing its bounds.
+func (n *node) unlock(owner uint64, start, end uint64) {
+	locks := n.locks[:0:0]
+	for _, l := range n.locks {
+		if l.owner != owner || l.Start > end || start > l.End {
+			locks = append(locks, l)
+			continue
+		}
+		if l.Start < start {
+			head := l
+			head.End = start - 1
+			locks = append(locks, head)
+		}
+		if l.End > end && end < math.MaxUint64 {
+			tail := l
+			tail.Start = end + 1
+			locks = append(locks, tail)
+		}
+	}
+	n.locks = locks
+	n.fsys.lockReleased()
+}
+
+// releaseLocks drops the locks taken through fh.
+func (n *node) releaseLocks(fh fs.FileHandle) {
+	locks := n.locks[:0:0]
+	for _, l := range n.locks {
+		if l.fh != fh {
+			locks = append(locks, l)
+		}
+	}
+	if len(locks) != len(n.locks) {
+		n.locks = locks
+		n.fsys.lockReleased()
+	}
+}
+
+// lockReleased wakes up the Setlkw callers, fsys.mu must be held.
+func (fsys *fileSystem) lockReleased() {
+	close(fsys.lockChanged)
+	fsys.lockChanged = make(chan struct{})
+}
+
+// setlk takes or releases lk, it returns the channel to wait on when the
+// lock is held by someone else.
+func (n *node) setlk(f fs.FileHandle, owner uint64, lk *fuse.FileLock) (<-chan struct{}, syscall.Errno) {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	switch lk.Typ {
+	case syscall.F_UNLCK:
+		n.unlock(owner, lk.Start, lk.End)
+		return nil, 0
+	case syscall.F_RDLCK, syscall.F_WRLCK:
+	default:
+		return nil, syscall.EINVAL
+	}
+	if n.conflict(owner, lk) != nil {
+		return n.fsys.lockChanged, syscall.EAGAIN
+	}
+	// Replacing the range of the owner may downgrade a write lock.
+	n.unlock(owner, lk.Start, lk.End)
+	n.locks = append(n.locks, lock{FileLock: *lk, fh: f, owner: owner})
+	return nil, 0
+}
+
+func (n *node) Getlk(ctx context.Context, f fs.FileHandle, owner uint64, lk *fuse.FileLock, flags uint32, out *fuse.FileLock) syscall.Errno {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	if l := n.conflict(owner, lk); l != nil {
+		*out = l.FileLock
+		return 0
+	}
+	*out = *lk
+	out.Typ = syscall.F_UNLCK
+	return 0
+}
+
+func (n *node) Setlk(ctx context.Context, f fs.FileHandle, owner uint64, lk *fuse.FileLock, flags uint32) syscall.Errno {
+	_, errno := n.setlk(f, owner, lk)
+	return errno
+}
+
+func (n *node) Setlkw(ctx context.Context, f fs.FileHandle, owner uint64, lk *fuse.FileLock, flags uint32) syscall.Errno {
+	for {
+		wait, errno := n.setlk(f, owner, lk)
+		if wait == nil {
+			return errno
+		}
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return syscall.EINTR
+		}
+	}
+}
@@ -0,0 +1,185 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package memfs is a file system keeping everything in memory. It is
+// meant to be served by fuse2grpc as scratch space and for tests.
+package memfs
+
+import (
+	"context"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+const (
+	blockSize = 4096
+	nameLen   = 255
+
+	// unlimitedBytes and unlimitedInodes are reported by Statfs when
+	// there is no cap.
+	unlimitedBytes  = 1 << 40
+	unlimitedInodes = 1 << 32
+
+	// maxFileSize caps the size of a file, larger ones fail with EFBIG.
+	maxFileSize = unlimitedBytes
+)
+
+// Options configure the file system returned by New.
+type Options struct {
+	// MaxBytes caps the size of all files together, 0 means no limit.
+	MaxBytes int64
+	// MaxInodes caps the number of files, directories and symlinks,
+	// 0 means no limit.
+	MaxInodes uint64
+	// Owner owns the root directory.
+	Owner fuse.Owner
+	// Mode is the permission of the root directory, 0755 when not set.
+	Mode uint32
+	// Timeout is how long the kernel may cache entries and attributes,
+	// one second when not set.
+	Timeout time.Duration
+}
+
+// fileSystem holds the state shared by all nodes. A single mutex
+// protects the whole tree, which keeps renames and hardlinks simple.
+type fileSystem struct {
+	mu   sync.Mutex
+	opts Options
+
+	nextIno uint64
+	bytes   int64
+	inodes  uint64
+
+	// ioSize is the block size reported to applications, the smallest
+	// MaxWrite of the clients once they mounted.
+	ioSize uint32
+
+	// lockChanged is closed and replaced whenever a lock is released,
+	// waking up Setlkw callers.
+	lockChanged chan struct{}
+}
+
+// New returns an empty in-memory file system.
+func New(opts Options) fuse.RawFileSystem {
+	if opts.Mode == 0 {
+		opts.Mode = 0755
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = time.Second
+	}
+	fsys := &fileSystem{
+		opts:        opts,
+		nextIno:     fuse.FUSE_ROOT_ID,
+		lockChanged: make(chan struct{}),
+	}
+	root, _ := fsys.newNode(syscall.S_IFDIR|opts.Mode, opts.Owner)
+
+	timeout := opts.Timeout
+	return &rawFileSystem{
+		RawFileSystem: fs.NewNodeFS(root, &fs.Options{
+			EntryTimeout:    &timeout,
+			AttrTimeout:     &timeout,
+			NullPermissions: true,
+		}),
+		fsys: fsys,
+	}
+}
+
+// newNode allocates a node, fsys.mu must be held except for the root.
+func (fsys *fileSystem) newNode(mode uint32, owner fuse.Owner) (*node, syscall.Errno) {
+	if fsys.opts.MaxInodes > 0 && fsys.inodes >= fsys.opts.MaxInodes {
+		return nil, syscall.ENOSPC
+	}
+	fsys.inodes++
+
+	now := time.Now()
+	n := &node{fsys: fsys}
+	n.attr.Ino = fsys.nextIno
+	n.attr.Mode = mode
+	n.attr.Nlink = 1
+	if mode&syscall.S_IFMT == syscall.S_IFDIR {
+		n.attr.Nlink = 2
+	}
+	n.attr.Owner = owner
+	n.attr.SetTimes(&now, &now, &now)
+	fsys.nextIno++
+	return n, 0
+}
+
+// reserve accounts delta bytes, failing with ENOSPC above the cap.
+func (fsys *fileSystem) reserve(delta int64) syscall.Errno {
+	if delta > 0 && fsys.opts.MaxBytes > 0 && fsys.bytes+delta > fsys.opts.MaxBytes {
+		return syscall.ENOSPC
+	}
+	fsys.bytes += delta
+	return 0
+}
+
+// caller returns the owner of new nodes.
+func caller(ctx context.Context) fuse.Owner {
+	if c, ok := ctx.(*fuse.Context); ok {
+		return c.Owner
+	}
+	return fuse.Owner{}
+}
+
+// blockSize returns the preferred size of reads and writes, fsys.mu
+// must be held.
+func (fsys *fileSystem) blockSize() uint32 {
+	if fsys.ioSize > 0 {
+		return fsys.ioSize
+	}
+	return blockSize
+}
+
+// statfs reports the caps as the size of the file system.
+func (fsys *fileSystem) statfs(out *fuse.StatfsOut) {
+	fsys.mu.Lock()
+	defer fsys.mu.Unlock()
+
+	total := uint64(unlimitedBytes)
+	if fsys.opts.MaxBytes > 0 {
+		total = uint64(fsys.opts.MaxBytes)
+	}
+	used := uint64(fsys.bytes)
+	free := uint64(0)
+	if total > used {
+		free = total - used
+	}
+	files := uint64(unlimitedInodes)
+	if fsys.opts.MaxInodes > 0 {
+		files = fsys.opts.MaxInodes
+	}
+	ffree := uint64(0)
+	if files > fsys.inodes {
+		ffree = files - fsys.inodes
+	}
+
+	*out = fuse.StatfsOut{
+		Blocks:  total / blockSize,
+		Bfree:   free / blockSize,
+		Bavail:  free / blockSize,
+		Files:   files,
+		Ffree:   ffree,
+		Bsize:   fsys.blockSize(),
+		NameLen: nameLen,
+		Frsize:  blockSize,
+	}
+}
@@ -0,0 +1,288 @@
+package memfs_test
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/chiyutianyi/grpcfuse/fuse2grpc"
+	"github.com/chiyutianyi/grpcfuse/memfs"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/utils"
+)
+
+func header(nodeID uint64) fuse.InHeader {
+	return fuse.InHeader{NodeId: nodeID, Caller: fuse.Caller{Owner: fuse.Owner{Uid: 1000, Gid: 1000}}}
+}
+
+func create(t *testing.T, rawFS fuse.RawFileSystem, parent uint64, name string) (uint64, uint64) {
+	var out fuse.CreateOut
+	require.Equal(t, fuse.OK, rawFS.Create(nil, &fuse.CreateIn{InHeader: header(parent), Mode: 0644}, name, &out))
+	return out.NodeId, out.Fh
+}
+
+func write(rawFS fuse.RawFileSystem, node, fh uint64, off uint64, data string) fuse.Status {
+	_, st := rawFS.Write(nil, &fuse.WriteIn{InHeader: header(node), Fh: fh, Offset: off, Size: uint32(len(data))}, []byte(data))
+	return st
+}
+
+func read(t *testing.T, rawFS fuse.RawFileSystem, node, fh uint64) string {
+	buf := make([]byte, 1<<16)
+	res, st := rawFS.Read(nil, &fuse.ReadIn{InHeader: header(node), Fh: fh, Size: uint32(len(buf))}, buf)
+	require.Equal(t, fuse.OK, st)
+	data, st := res.Bytes(buf)
+	require.Equal(t, fuse.OK, st)
+	return string(data)
+}
+
+func getattr(t *testing.T, rawFS fuse.RawFileSystem, node uint64) fuse.Attr {
+	var out fuse.AttrOut
+	require.Equal(t, fuse.OK, rawFS.GetAttr(nil, &fuse.GetAttrIn{InHeader: header(node)}, &out))
+	return out.Attr
+}
+
+func TestFiles(t *testing.T) {
+	rawFS := memfs.New(memfs.Options{})
+	root := uint64(fuse.FUSE_ROOT_ID)
+
+	var dir fuse.EntryOut
+	require.Equal(t, fuse.OK, rawFS.Mkdir(nil, &fuse.MkdirIn{InHeader: header(root), Mode: 0755}, "dir", &dir))
+	require.Equal(t, uint32(syscall.S_IFDIR|0755), dir.Mode)
+	require.Equal(t, uint32(3), getattr(t, rawFS, root).Nlink)
+
+	node, fh := create(t, rawFS, dir.NodeId, "file")
+	require.Equal(t, fuse.OK, write(rawFS, node, fh, 0, "hello"))
+	require.Equal(t, fuse.OK, write(rawFS, node, fh, 5, " world"))
+	require.Equal(t, "hello world", read(t, rawFS, node, fh))
+
+	attr := getattr(t, rawFS, node)
+	require.Equal(t, uint64(11), attr.Size)
+	require.Equal(t, uint32(1000), attr.Uid)
+
+	in := &fuse.SetAttrIn{}
+	in.NodeId, in.Valid, in.Size = node, fuse.FATTR_SIZE, 5
+	var out fuse.AttrOut
+	require.Equal(t, fuse.OK, rawFS.SetAttr(nil, in, &out))
+	require.Equal(t, uint64(5), out.Size)
+	require.Equal(t, "hello", read(t, rawFS, node, fh))
+
+	require.Equal(t, fuse.Status(syscall.ENOTEMPTY), rawFS.Rmdir(nil, &fuse.InHeader{NodeId: root}, "dir"))
+	require.Equal(t, fuse.OK, rawFS.Rename(nil, &fuse.RenameIn{InHeader: header(dir.NodeId), Newdir: root}, "file", "moved"))
+	var entry fuse.EntryOut
+	require.Equal(t, fuse.OK, rawFS.Lookup(nil, &fuse.InHeader{NodeId: root}, "moved", &entry))
+	require.Equal(t, node, entry.NodeId)
+	require.Equal(t, fuse.ENOENT, rawFS.Lookup(nil, &fuse.InHeader{NodeId: dir.NodeId}, "file", &entry))
+
+	require.Equal(t, fuse.Status(syscall.EINVAL), rawFS.Rename(nil, &fuse.RenameIn{InHeader: header(root), Newdir: dir.NodeId}, "dir", "sub"))
+	require.Equal(t, fuse.OK, rawFS.Rmdir(nil, &fuse.InHeader{NodeId: root}, "dir"))
+	require.Equal(t, uint32(2), getattr(t, rawFS, root).Nlink)
+	require.Equal(t, fuse.OK, rawFS.Unlink(nil, &fuse.InHeader{NodeId: root}, "moved"))
+}
+
+func TestForget(t *testing.T) {
+	rawFS := memfs.New(memfs.Options{})
+	root := uint64(fuse.FUSE_ROOT_ID)
+
+	node, fh := create(t, rawFS, root, "file")
+	require.Equal(t, fuse.OK, write(rawFS, node, fh, 0, "kept"))
+	rawFS.Release(nil, &fuse.ReleaseIn{InHeader: header(node), Fh: fh})
+	rawFS.Forget(node, 1)
+
+	// the file outlives the lookups of the clients
+	var out fuse.EntryOut
+	require.Equal(t, fuse.OK, rawFS.Lookup(nil, &fuse.InHeader{NodeId: root}, "file", &out))
+	require.Equal(t, uint64(4), out.Size)
+}
+
+func TestLinks(t *testing.T) {
+	rawFS := memfs.New(memfs.Options{})
+	root := uint64(fuse.FUSE_ROOT_ID)
+
+	var link fuse.EntryOut
+	require.Equal(t, fuse.OK, rawFS.Symlink(nil, &fuse.InHeader{NodeId: root}, "target", "symlink", &link))
+	target, st := rawFS.Readlink(nil, &fuse.InHeader{NodeId: link.NodeId})
+	require.Equal(t, fuse.OK, st)
+	require.Equal(t, "target", string(target))
+
+	node, _ := create(t, rawFS, root, "file")
+	var hard fuse.EntryOut
+	require.Equal(t, fuse.OK, rawFS.Link(nil, &fuse.LinkIn{InHeader: header(root), Oldnodeid: node}, "hardlink", &hard))
+	require.Equal(t, node, hard.NodeId)
+	require.Equal(t, uint32(2), getattr(t, rawFS, node).Nlink)
+
+	require.Equal(t, fuse.OK, rawFS.Unlink(nil, &fuse.InHeader{NodeId: root}, "file"))
+	require.Equal(t, uint32(1), getattr(t, rawFS, node).Nlink)
+}
+
+func TestXAttr(t *testing.T) {
+	rawFS := memfs.New(memfs.Options{})
+	root := uint64(fuse.FUSE_ROOT_ID)
+
+	require.Equal(t, fuse.OK, rawFS.SetXAttr(nil, &fuse.SetXAttrIn{InHeader: header(root)}, "user.a", []byte("value")))
+	require.Equal(t, fuse.Status(syscall.EEXIST), rawFS.SetXAttr(nil, &fuse.SetXAttrIn{InHeader: header(root), Flags: 1}, "user.a", []byte("value")))
+
+	sz, st := rawFS.GetXAttr(nil, &fuse.InHeader{NodeId: root}, "user.a", nil)
+	require.Equal(t, fuse.ERANGE, st)
+	require.Equal(t, uint32(5), sz)
+	buf := make([]byte, 16)
+	sz, st = rawFS.GetXAttr(nil, &fuse.InHeader{NodeId: root}, "user.a", buf)
+	require.Equal(t, fuse.OK, st)
+	require.Equal(t, "value", string(buf[:sz]))
+
+	sz, st = rawFS.ListXAttr(nil, &fuse.InHeader{NodeId: root}, buf)
+	require.Equal(t, fuse.OK, st)
+	require.Equal(t, "user.a\x00", string(buf[:sz]))
+
+	require.Equal(t, fuse.OK, rawFS.RemoveXAttr(nil, &fuse.InHeader{NodeId: root}, "user.a"))
+	_, st = rawFS.GetXAttr(nil, &fuse.InHeader{NodeId: root}, "user.a", buf)
+	require.Equal(t, fuse.ENOATTR, st)
+}
+
+func TestLocks(t *testing.T) {
+	rawFS := memfs.New(memfs.Options{})
+	node, fh := create(t, rawFS, fuse.FUSE_ROOT_ID, "file")
+	lk := func(owner uint64, typ uint32) *fuse.LkIn {
+		return &fuse.LkIn{InHeader: header(node), Fh: fh, Owner: owner, Lk: fuse.FileLock{End: 99, Typ: typ}}
+	}
+
+	require.Equal(t, fuse.OK, rawFS.SetLk(nil, lk(1, syscall.F_WRLCK)))
+	require.Equal(t, fuse.EAGAIN, rawFS.SetLk(nil, lk(2, syscall.F_RDLCK)))
+
+	var out fuse.LkOut
+	require.Equal(t, fuse.OK, rawFS.GetLk(nil, lk(2, syscall.F_RDLCK), &out))
+	require.Equal(t, uint32(syscall.F_WRLCK), out.Lk.Typ)
+
+	done := make(chan fuse.Status)
+	go func() {
+		done <- rawFS.SetLkw(nil, lk(2, syscall.F_RDLCK))
+	}()
+	select {
+	case <-done:
+		t.Fatal("lock taken while held")
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.Equal(t, fuse.OK, rawFS.SetLk(nil, lk(1, syscall.F_UNLCK)))
+	require.Equal(t, fuse.OK, <-done)
+
+	cancel := make(chan struct{})
+	go func() {
+		done <- rawFS.SetLkw(cancel, lk(1, syscall.F_WRLCK))
+	}()
+	close(cancel)
+	require.Equal(t, fuse.EINTR, <-done)
+
+	// The read lock of owner 2 goes away with the handle.
+	rawFS.Release(nil, &fuse.ReleaseIn{InHeader: header(node), Fh: fh})
+	var open fuse.OpenOut
+	require.Equal(t, fuse.OK, rawFS.Open(nil, &fuse.OpenIn{InHeader: header(node)}, &open))
+	fh = open.Fh
+	require.Equal(t, fuse.OK, rawFS.SetLk(nil, lk(1, syscall.F_WRLCK)))
+
+	// Releasing another handle keeps it.
+	require.Equal(t, fuse.OK, rawFS.Open(nil, &fuse.OpenIn{InHeader: header(node)}, &open))
+	rawFS.Release(nil, &fuse.ReleaseIn{InHeader: header(node), Fh: open.Fh})
+	require.Equal(t, fuse.EAGAIN, rawFS.SetLk(nil, lk(2, syscall.F_WRLCK)))
+}
+
+func TestQuota(t *testing.T) {
+	rawFS := memfs.New(memfs.Options{MaxBytes: 8192, MaxInodes: 3})
+	root := uint64(fuse.FUSE_ROOT_ID)
+	node, fh := create(t, rawFS, root, "file")
+
+	require.Equal(t, fuse.OK, rawFS.Fallocate(nil, &fuse.FallocateIn{InHeader: header(node), Fh: fh, Length: 4096}))
+	require.Equal(t, uint64(4096), getattr(t, rawFS, node).Size)
+	require.Equal(t, fuse.OK, write(rawFS, node, fh, 0, "data"))
+
+	var statfs fuse.StatfsOut
+	require.Equal(t, fuse.OK, rawFS.StatFs(nil, &fuse.InHeader{NodeId: root}, &statfs))
+	require.Equal(t, uint64(2), statfs.Blocks)
+	require.Equal(t, uint64(1), statfs.Bfree)
+	require.Equal(t, uint64(1), statfs.Ffree)
+
+	copied, _ := create(t, rawFS, root, "copy")
+	n, st := rawFS.CopyFileRange(nil, &fuse.CopyFileRangeIn{InHeader: header(node), FhIn: fh, NodeIdOut: copied, Len: 4})
+	require.Equal(t, fuse.OK, st)
+	require.Equal(t, uint32(4), n)
+	require.Equal(t, uint64(4), getattr(t, rawFS, copied).Size)
+
+	var out fuse.CreateOut
+	require.Equal(t, fuse.Status(syscall.ENOSPC), rawFS.Create(nil, &fuse.CreateIn{InHeader: header(root), Mode: 0644}, "full", &out))
+	require.Equal(t, fuse.Status(syscall.ENOSPC), write(rawFS, node, fh, 4096, string(make([]byte, 4096))))
+	require.Equal(t, fuse.Status(syscall.ENOSPC), rawFS.Fallocate(nil, &fuse.FallocateIn{InHeader: header(node), Fh: fh, Length: 8192}))
+
+	require.Equal(t, fuse.OK, rawFS.Unlink(nil, &fuse.InHeader{NodeId: root}, "copy"))
+	require.Equal(t, fuse.OK, write(rawFS, node, fh, 4096, string(make([]byte, 4096))))
+}
+
+func TestInitKernel(t *testing.T) {
+	rawFS := memfs.New(memfs.Options{})
+	require.Implements(t, (*fuse2grpc.KernelInitializer)(nil), rawFS)
+
+	socket := filepath.Join(t.TempDir(), "memfs.sock")
+	l, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	srv := fuse2grpc.NewServer(rawFS)
+	s := grpc.NewServer(grpc.StatsHandler(srv.StatsHandler()))
+	pb.RegisterRawFileSystemServer(s, srv)
+	go s.Serve(l)
+	defer s.Stop()
+
+	conn, err := grpc.Dial("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := pb.NewRawFileSystemClient(conn)
+
+	root := uint64(fuse.FUSE_ROOT_ID)
+	var statfs fuse.StatfsOut
+	require.Equal(t, fuse.OK, rawFS.StatFs(nil, &fuse.InHeader{NodeId: root}, &statfs))
+	require.Equal(t, uint32(4096), statfs.Bsize)
+
+	for i, maxWrite := range []int32{1 << 20, 1 << 17, 1 << 18} {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), utils.ClientIDKey, string(rune('a'+i)))
+		_, err := client.Init(ctx, &pb.InitRequest{
+			Major:        utils.ProtocolMajor,
+			Kernel:       &pb.KernelSettings{Major: 7, Minor: 31},
+			MountOptions: &pb.MountOptions{MaxWrite: maxWrite},
+		})
+		require.NoError(t, err)
+	}
+
+	// the smallest MaxWrite of the clients wins
+	require.Equal(t, fuse.OK, rawFS.StatFs(nil, &fuse.InHeader{NodeId: root}, &statfs))
+	require.Equal(t, uint32(1<<17), statfs.Bsize)
+	require.Equal(t, uint32(4096), statfs.Frsize)
+}
+
+func TestFileSize(t *testing.T) {
+	rawFS := memfs.New(memfs.Options{})
+	root := uint64(fuse.FUSE_ROOT_ID)
+	node, fh := create(t, rawFS, root, "file")
+
+	require.Equal(t, fuse.Status(syscall.EFBIG), write(rawFS, node, fh, 1<<62, "data"))
+	require.Equal(t, fuse.Status(syscall.EFBIG), write(rawFS, node, fh, 1<<40, "data"))
+	require.Equal(t, fuse.Status(syscall.EINVAL), write(rawFS, node, fh, 1<<63, "data"))
+	require.Equal(t, fuse.Status(syscall.EFBIG), rawFS.Fallocate(nil, &fuse.FallocateIn{InHeader: header(node), Fh: fh, Offset: 1, Length: 1<<64 - 1}))
+	require.Equal(t, fuse.Status(syscall.EFBIG), rawFS.Fallocate(nil, &fuse.FallocateIn{InHeader: header(node), Fh: fh, Length: 1 << 63}))
+
+	var out fuse.AttrOut
+	require.Equal(t, fuse.Status(syscall.EFBIG), rawFS.SetAttr(nil, &fuse.SetAttrIn{
+		SetAttrInCommon: fuse.SetAttrInCommon{InHeader: header(node), Valid: fuse.FATTR_SIZE, Size: 1 << 63},
+	}, &out))
+	require.Equal(t, uint64(0), getattr(t, rawFS, node).Size)
+
+	require.Equal(t, fuse.OK, write(rawFS, node, fh, 0, "data"))
+	require.Equal(t, fuse.OK, rawFS.Fallocate(nil, &fuse.FallocateIn{InHeader: header(node), Fh: fh, Offset: 2, Length: 1<<64 - 1, Mode: 0x3}))
+	n, st := rawFS.CopyFileRange(nil, &fuse.CopyFileRangeIn{InHeader: header(node), FhIn: fh, OffIn: 1, NodeIdOut: node, OffOut: 4, Len: 1<<64 - 1})
+	require.Equal(t, fuse.OK, st)
+	require.Equal(t, uint32(3), n)
+	require.Equal(t, "da\x00\x00a\x00\x00", read(t, rawFS, node, fh))
+}
@@ -0,0 +1,432 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memfs
+
+import (
+	"context"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+// node is a file, directory, symlink or special file. The children of
+// directories are kept by the embedded fs.Inode.
+type node struct {
+	fs.Inode
+
+	fsys *fileSystem
+
+	attr   fuse.Attr
+	data   []byte
+	target []byte
+	xattrs map[string][]byte
+	locks  []lock
+}
+
+var (
+	_ = (fs.NodeStatfser)((*node)(nil))
+	_ = (fs.NodeGetattrer)((*node)(nil))
+	_ = (fs.NodeSetattrer)((*node)(nil))
+	_ = (fs.NodeReadlinker)((*node)(nil))
+	_ = (fs.NodeLookuper)((*node)(nil))
+	_ = (fs.NodeMkdirer)((*node)(nil))
+	_ = (fs.NodeMknoder)((*node)(nil))
+	_ = (fs.NodeCreater)((*node)(nil))
+	_ = (fs.NodeSymlinker)((*node)(nil))
+	_ = (fs.NodeLinker)((*node)(nil))
+	_ = (fs.NodeUnlinker)((*node)(nil))
+	_ = (fs.NodeRmdirer)((*node)(nil))
+	_ = (fs.NodeRenamer)((*node)(nil))
+)
+
+// renameNoReplace is RENAME_NOREPLACE of Linux, go-fuse passes the
+// rename flags through as is.
+const renameNoReplace = 0x1
+
+func (n *node) isDir() bool {
+	return n.attr.Mode&syscall.S_IFMT == syscall.S_IFDIR
+}
+
+func (n *node) isRegular() bool {
+	return n.attr.Mode&syscall.S_IFMT == syscall.S_IFREG
+}
+
+// fillAttr copies the attributes of n, n.fsys.mu must be held.
+func (n *node) fillAttr(out *fuse.Attr) {
+	*out = n.attr
+	switch {
+	case n.isRegular():
+		out.Size = uint64(len(n.data))
+	case n.attr.Mode&syscall.S_IFMT == syscall.S_IFLNK:
+		out.Size = uint64(len(n.target))
+	case n.isDir():
+		out.Size = blockSize
+	}
+	out.Blocks = (out.Size + 511) / 512
+	setBlksize(out, n.fsys.blockSize())
+}
+
+// touch updates the modification and change times of n.
+func (n *node) touch() {
+	now := time.Now()
+	n.attr.SetTimes(nil, &now, &now)
+}
+
+// fileEnd returns off+length, failing with EFBIG past maxFileSize.
+func fileEnd(off, length uint64) (uint64, syscall.Errno) {
+	if off > maxFileSize || length > maxFileSize-off {
+		return 0, syscall.EFBIG
+	}
+	return off + length, 0
+}
+
+// resize grows or shrinks the data of a regular file, failing with EFBIG
+// past maxFileSize. Unlinked files no longer count against the cap.
+func (n *node) resize(size uint64) syscall.Errno {
+	if size > maxFileSize {
+		return syscall.EFBIG
+	}
+	if n.attr.Nlink > 0 {
+		if errno := n.fsys.reserve(int64(size) - int64(len(n.data))); errno != 0 {
+			return errno
+		}
+	}
+	if size <= uint64(cap(n.data)) {
+		old := len(n.data)
+		n.data = n.data[:size]
+		for i := old; i < len(n.data); i++ {
+			n.data[i] = 0
+		}
+		return 0
+	}
+	data := make([]byte, size, size+size/4)
+	copy(data, n.data)
+	n.data = data
+	return 0
+}
+
+// unlink drops a hardlink of n, releasing its space with the last one.
+func (n *node) unlink() {
+	n.attr.Nlink--
+	now := time.Now()
+	n.attr.SetTimes(nil, nil, &now)
+	if n.attr.Nlink == 0 {
+		n.fsys.bytes -= int64(len(n.data))
+		n.fsys.inodes--
+	}
+}
+
+func (n *node) Statfs(ctx context.Context, out *fuse.StatfsOut) syscall.Errno {
+	n.fsys.statfs(out)
+	return 0
+}
+
+func (n *node) Getattr(ctx context.Context, f fs.FileHandle, out *fuse.AttrOut) syscall.Errno {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	n.fillAttr(&out.Attr)
+	return 0
+}
+
+func (n *node) Setattr(ctx context.Context, f fs.FileHandle, in *fuse.SetAttrIn, out *fuse.AttrOut) syscall.Errno {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	if size, ok := in.GetSize(); ok {
+		if n.isDir() {
+			return syscall.EISDIR
+		}
+		if !n.isRegular() {
+			return syscall.EINVAL
+		}
+		if errno := n.resize(size); errno != 0 {
+			return errno
+		}
+		n.touch()
+	}
+	if mode, ok := in.GetMode(); ok {
+		n.attr.Mode = n.attr.Mode&syscall.S_IFMT | mode&07777
+	}
+	if uid, ok := in.GetUID(); ok {
+		n.attr.Uid = uid
+	}
+	if gid, ok := in.GetGID(); ok {
+		n.attr.Gid = gid
+	}
+	var atime, mtime *time.Time
+	if t, ok := in.GetATime(); ok {
+		atime = &t
+	}
+	if t, ok := in.GetMTime(); ok {
+		mtime = &t
+	}
+	now := time.Now()
+	n.attr.SetTimes(atime, mtime, &now)
+
+	n.fillAttr(&out.Attr)
+	return 0
+}
+
+func (n *node) Readlink(ctx context.Context) ([]byte, syscall.Errno) {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	if n.attr.Mode&syscall.S_IFMT != syscall.S_IFLNK {
+		return nil, syscall.EINVAL
+	}
+	return append([]byte(nil), n.target...), 0
+}
+
+func (n *node) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	child := n.GetChild(name)
+	if child == nil {
+		return nil, syscall.ENOENT
+	}
+	child.Operations().(*node).fillAttr(&out.Attr)
+	return child, 0
+}
+
+// newChild creates a node named name in the directory n, n.fsys.mu must
+// be held. The inode is persistent as the tree is the only copy of the
+// data, it must outlive the lookups of the clients.
+func (n *node) newChild(ctx context.Context, name string, mode uint32, out *fuse.EntryOut) (*node, *fs.Inode, syscall.Errno) {
+	if n.GetChild(name) != nil {
+		return nil, nil, syscall.EEXIST
+	}
+	if len(name) > nameLen {
+		return nil, nil, syscall.ENAMETOOLONG
+	}
+	child, errno := n.fsys.newNode(mode, caller(ctx))
+	if errno != 0 {
+		return nil, nil, errno
+	}
+	if child.isDir() {
+		n.attr.Nlink++
+	}
+	n.touch()
+
+	child.fillAttr(&out.Attr)
+	inode := n.NewPersistentInode(ctx, child, fs.StableAttr{Mode: mode & syscall.S_IFMT, Ino: child.attr.Ino})
+	return child, inode, 0
+}
+
+func (n *node) Mkdir(ctx context.Context, name string, mode uint32, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	_, inode, errno := n.newChild(ctx, name, syscall.S_IFDIR|mode&07777, out)
+	return inode, errno
+}
+
+func (n *node) Mknod(ctx context.Context, name string, mode uint32, dev uint32, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	if mode&syscall.S_IFMT == 0 {
+		mode |= syscall.S_IFREG
+	}
+	child, inode, errno := n.newChild(ctx, name, mode, out)
+	if errno != 0 {
+		return nil, errno
+	}
+	child.attr.Rdev = dev
+	out.Attr.Rdev = dev
+	return inode, 0
+}
+
+func (n *node) Create(ctx context.Context, name string, flags uint32, mode uint32, out *fuse.EntryOut) (*fs.Inode, fs.FileHandle, uint32, syscall.Errno) {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	_, inode, errno := n.newChild(ctx, name, syscall.S_IFREG|mode&07777, out)
+	if errno != 0 {
+		return nil, nil, 0, errno
+	}
+	return inode, &handle{}, 0, 0
+}
+
+func (n *node) Symlink(ctx context.Context, target, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	child, inode, errno := n.newChild(ctx, name, syscall.S_IFLNK|0777, out)
+	if errno != 0 {
+		return nil, errno
+	}
+	child.target = []byte(target)
+	out.Attr.Size = uint64(len(target))
+	return inode, 0
+}
+
+func (n *node) Link(ctx context.Context, target fs.InodeEmbedder, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	t, ok := target.(*node)
+	if !ok {
+		return nil, syscall.EXDEV
+	}
+	if t.isDir() {
+		return nil, syscall.EPERM
+	}
+	if n.GetChild(name) != nil {
+		return nil, syscall.EEXIST
+	}
+	t.attr.Nlink++
+	now := time.Now()
+	t.attr.SetTimes(nil, nil, &now)
+	n.touch()
+
+	t.fillAttr(&out.Attr)
+	return t.EmbeddedInode(), 0
+}
+
+func (n *node) Unlink(ctx context.Context, name string) syscall.Errno {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	child := n.GetChild(name)
+	if child == nil {
+		return syscall.ENOENT
+	}
+	c := child.Operations().(*node)
+	if c.isDir() {
+		return syscall.EISDIR
+	}
+	c.unlink()
+	n.touch()
+	return 0
+}
+
+// rmdir drops the directory c from its parent n, after checking it is
+// empty.
+func (n *node) rmdir(c *node) syscall.Errno {
+	if len(c.Children()) > 0 {
+		return syscall.ENOTEMPTY
+	}
+	c.attr.Nlink = 0
+	n.attr.Nlink--
+	n.fsys.inodes--
+	n.touch()
+	return 0
+}
+
+func (n *node) Rmdir(ctx context.Context, name string) syscall.Errno {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	child := n.GetChild(name)
+	if child == nil {
+		return syscall.ENOENT
+	}
+	c := child.Operations().(*node)
+	if !c.isDir() {
+		return syscall.ENOTDIR
+	}
+	return n.rmdir(c)
+}
+
+// isAncestor tells whether c is p or one of its parents.
+func isAncestor(c *fs.Inode, p *fs.Inode) bool {
+	for p != nil {
+		if p == c {
+			return true
+		}
+		_, p = p.Parent()
+	}
+	return false
+}
+
+// Rename only checks and accounts, the children are moved by go-fuse
+// once it succeeds.
+func (n *node) Rename(ctx context.Context, name string, newParent fs.InodeEmbedder, newName string, flags uint32) syscall.Errno {
+	n.fsys.mu.Lock()
+	defer n.fsys.mu.Unlock()
+
+	np, ok := newParent.(*node)
+	if !ok {
+		return syscall.EXDEV
+	}
+	child := n.GetChild(name)
+	if child == nil {
+		return syscall.ENOENT
+	}
+	c := child.Operations().(*node)
+	if c.isDir() && isAncestor(child, np.EmbeddedInode()) {
+		return syscall.EINVAL
+	}
+	target := np.GetChild(newName)
+
+	if flags&fs.RENAME_EXCHANGE != 0 {
+		if target == nil {
+			return syscall.ENOENT
+		}
+		t := target.Operations().(*node)
+		if t.isDir() && isAncestor(target, n.EmbeddedInode()) {
+			return syscall.EINVAL
+		}
+		if c.isDir() != t.isDir() && n != np {
+			if c.isDir() {
+				n.attr.Nlink--
+				np.attr.Nlink++
+			} else {
+				n.attr.Nlink++
+				np.attr.Nlink--
+			}
+		}
+		n.touch()
+		np.touch()
+		return 0
+	}
+
+	if target != nil {
+		if flags&renameNoReplace != 0 {
+			return syscall.EEXIST
+		}
+		if target == child {
+			return 0
+		}
+		t := target.Operations().(*node)
+		switch {
+		case c.isDir() && !t.isDir():
+			return syscall.ENOTDIR
+		case !c.isDir() && t.isDir():
+			return syscall.EISDIR
+		case t.isDir():
+			if errno := np.rmdir(t); errno != 0 {
+				return errno
+			}
+		default:
+			t.unlink()
+		}
+	}
+	if c.isDir() && n != np {
+		n.attr.Nlink--
+		np.attr.Nlink++
+	}
+	now := time.Now()
+	c.attr.SetTimes(nil, nil, &now)
+	n.touch()
+	np.touch()
+	return 0
+}
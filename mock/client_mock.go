@@ -58,6 +58,26 @@ func (mr *MockRawFileSystemClientMockRecorder) Access(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Access", reflect.TypeOf((*MockRawFileSystemClient)(nil).Access), varargs...)
 }
 
+// BatchForget mocks base method.
+func (m *MockRawFileSystemClient) BatchForget(ctx context.Context, in *pb.BatchForgetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchForget", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchForget indicates an expected call of BatchForget.
+func (mr *MockRawFileSystemClientMockRecorder) BatchForget(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchForget", reflect.TypeOf((*MockRawFileSystemClient)(nil).BatchForget), varargs...)
+}
+
 // CopyFileRange mocks base method.
 func (m *MockRawFileSystemClient) CopyFileRange(ctx context.Context, in *pb.CopyFileRangeRequest, opts ...grpc.CallOption) (*pb.CopyFileRangeResponse, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +278,26 @@ func (mr *MockRawFileSystemClientMockRecorder) GetXAttr(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXAttr", reflect.TypeOf((*MockRawFileSystemClient)(nil).GetXAttr), varargs...)
 }
 
+// Init mocks base method.
+func (m *MockRawFileSystemClient) Init(ctx context.Context, in *pb.InitRequest, opts ...grpc.CallOption) (*pb.InitResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Init", varargs...)
+	ret0, _ := ret[0].(*pb.InitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Init indicates an expected call of Init.
+func (mr *MockRawFileSystemClientMockRecorder) Init(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockRawFileSystemClient)(nil).Init), varargs...)
+}
+
 // Link mocks base method.
 func (m *MockRawFileSystemClient) Link(ctx context.Context, in *pb.LinkRequest, opts ...grpc.CallOption) (*pb.LinkResponse, error) {
 	m.ctrl.T.Helper()
@@ -278,6 +318,26 @@ func (mr *MockRawFileSystemClientMockRecorder) Link(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Link", reflect.TypeOf((*MockRawFileSystemClient)(nil).Link), varargs...)
 }
 
+// ListExports mocks base method.
+func (m *MockRawFileSystemClient) ListExports(ctx context.Context, in *pb.ListExportsRequest, opts ...grpc.CallOption) (*pb.ListExportsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListExports", varargs...)
+	ret0, _ := ret[0].(*pb.ListExportsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExports indicates an expected call of ListExports.
+func (mr *MockRawFileSystemClientMockRecorder) ListExports(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExports", reflect.TypeOf((*MockRawFileSystemClient)(nil).ListExports), varargs...)
+}
+
 // ListXAttr mocks base method.
 func (m *MockRawFileSystemClient) ListXAttr(ctx context.Context, in *pb.ListXAttrRequest, opts ...grpc.CallOption) (*pb.ListXAttrResponse, error) {
 	m.ctrl.T.Helper()
@@ -378,6 +438,26 @@ func (mr *MockRawFileSystemClientMockRecorder) Mknod(ctx, in interface{}, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mknod", reflect.TypeOf((*MockRawFileSystemClient)(nil).Mknod), varargs...)
 }
 
+// Notify mocks base method.
+func (m *MockRawFileSystemClient) Notify(ctx context.Context, in *pb.NotifyRequest, opts ...grpc.CallOption) (pb.RawFileSystem_NotifyClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Notify", varargs...)
+	ret0, _ := ret[0].(pb.RawFileSystem_NotifyClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockRawFileSystemClientMockRecorder) Notify(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockRawFileSystemClient)(nil).Notify), varargs...)
+}
+
 // Open mocks base method.
 func (m *MockRawFileSystemClient) Open(ctx context.Context, in *pb.OpenRequest, opts ...grpc.CallOption) (*pb.OpenResponse, error) {
 	m.ctrl.T.Helper()
@@ -778,6 +858,149 @@ func (mr *MockRawFileSystemClientMockRecorder) Write(ctx, in interface{}, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockRawFileSystemClient)(nil).Write), varargs...)
 }
 
+// WriteStream mocks base method.
+func (m *MockRawFileSystemClient) WriteStream(ctx context.Context, opts ...grpc.CallOption) (pb.RawFileSystem_WriteStreamClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WriteStream", varargs...)
+	ret0, _ := ret[0].(pb.RawFileSystem_WriteStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteStream indicates an expected call of WriteStream.
+func (mr *MockRawFileSystemClientMockRecorder) WriteStream(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteStream", reflect.TypeOf((*MockRawFileSystemClient)(nil).WriteStream), varargs...)
+}
+
+// MockRawFileSystem_NotifyClient is a mock of RawFileSystem_NotifyClient interface.
+type MockRawFileSystem_NotifyClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockRawFileSystem_NotifyClientMockRecorder
+}
+
+// MockRawFileSystem_NotifyClientMockRecorder is the mock recorder for MockRawFileSystem_NotifyClient.
+type MockRawFileSystem_NotifyClientMockRecorder struct {
+	mock *MockRawFileSystem_NotifyClient
+}
+
+// NewMockRawFileSystem_NotifyClient creates a new mock instance.
+func NewMockRawFileSystem_NotifyClient(ctrl *gomock.Controller) *MockRawFileSystem_NotifyClient {
+	mock := &MockRawFileSystem_NotifyClient{ctrl: ctrl}
+	mock.recorder = &MockRawFileSystem_NotifyClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRawFileSystem_NotifyClient) EXPECT() *MockRawFileSystem_NotifyClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockRawFileSystem_NotifyClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockRawFileSystem_NotifyClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockRawFileSystem_NotifyClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockRawFileSystem_NotifyClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockRawFileSystem_NotifyClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockRawFileSystem_NotifyClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockRawFileSystem_NotifyClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockRawFileSystem_NotifyClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockRawFileSystem_NotifyClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockRawFileSystem_NotifyClient) Recv() (*pb.NotifyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.NotifyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockRawFileSystem_NotifyClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockRawFileSystem_NotifyClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockRawFileSystem_NotifyClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockRawFileSystem_NotifyClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockRawFileSystem_NotifyClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockRawFileSystem_NotifyClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockRawFileSystem_NotifyClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockRawFileSystem_NotifyClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockRawFileSystem_NotifyClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockRawFileSystem_NotifyClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockRawFileSystem_NotifyClient)(nil).Trailer))
+}
+
 // MockRawFileSystem_ReadClient is a mock of RawFileSystem_ReadClient interface.
 type MockRawFileSystem_ReadClient struct {
 	ctrl     *gomock.Controller
@@ -901,6 +1124,143 @@ func (mr *MockRawFileSystem_ReadClientMockRecorder) Trailer() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockRawFileSystem_ReadClient)(nil).Trailer))
 }
 
+// MockRawFileSystem_WriteStreamClient is a mock of RawFileSystem_WriteStreamClient interface.
+type MockRawFileSystem_WriteStreamClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockRawFileSystem_WriteStreamClientMockRecorder
+}
+
+// MockRawFileSystem_WriteStreamClientMockRecorder is the mock recorder for MockRawFileSystem_WriteStreamClient.
+type MockRawFileSystem_WriteStreamClientMockRecorder struct {
+	mock *MockRawFileSystem_WriteStreamClient
+}
+
+// NewMockRawFileSystem_WriteStreamClient creates a new mock instance.
+func NewMockRawFileSystem_WriteStreamClient(ctrl *gomock.Controller) *MockRawFileSystem_WriteStreamClient {
+	mock := &MockRawFileSystem_WriteStreamClient{ctrl: ctrl}
+	mock.recorder = &MockRawFileSystem_WriteStreamClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRawFileSystem_WriteStreamClient) EXPECT() *MockRawFileSystem_WriteStreamClientMockRecorder {
+	return m.recorder
+}
+
+// CloseAndRecv mocks base method.
+func (m *MockRawFileSystem_WriteStreamClient) CloseAndRecv() (*pb.WriteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAndRecv")
+	ret0, _ := ret[0].(*pb.WriteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAndRecv indicates an expected call of CloseAndRecv.
+func (mr *MockRawFileSystem_WriteStreamClientMockRecorder) CloseAndRecv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockRawFileSystem_WriteStreamClient)(nil).CloseAndRecv))
+}
+
+// CloseSend mocks base method.
+func (m *MockRawFileSystem_WriteStreamClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockRawFileSystem_WriteStreamClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockRawFileSystem_WriteStreamClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockRawFileSystem_WriteStreamClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockRawFileSystem_WriteStreamClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockRawFileSystem_WriteStreamClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockRawFileSystem_WriteStreamClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockRawFileSystem_WriteStreamClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockRawFileSystem_WriteStreamClient)(nil).Header))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockRawFileSystem_WriteStreamClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockRawFileSystem_WriteStreamClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockRawFileSystem_WriteStreamClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockRawFileSystem_WriteStreamClient) Send(arg0 *pb.WriteRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockRawFileSystem_WriteStreamClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockRawFileSystem_WriteStreamClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockRawFileSystem_WriteStreamClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockRawFileSystem_WriteStreamClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockRawFileSystem_WriteStreamClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockRawFileSystem_WriteStreamClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockRawFileSystem_WriteStreamClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockRawFileSystem_WriteStreamClient)(nil).Trailer))
+}
+
 // MockRawFileSystem_ReadDirClient is a mock of RawFileSystem_ReadDirClient interface.
 type MockRawFileSystem_ReadDirClient struct {
 	ctrl     *gomock.Controller
@@ -1185,6 +1545,21 @@ func (mr *MockRawFileSystemServerMockRecorder) Access(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Access", reflect.TypeOf((*MockRawFileSystemServer)(nil).Access), arg0, arg1)
 }
 
+// BatchForget mocks base method.
+func (m *MockRawFileSystemServer) BatchForget(arg0 context.Context, arg1 *pb.BatchForgetRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchForget", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchForget indicates an expected call of BatchForget.
+func (mr *MockRawFileSystemServerMockRecorder) BatchForget(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchForget", reflect.TypeOf((*MockRawFileSystemServer)(nil).BatchForget), arg0, arg1)
+}
+
 // CopyFileRange mocks base method.
 func (m *MockRawFileSystemServer) CopyFileRange(arg0 context.Context, arg1 *pb.CopyFileRangeRequest) (*pb.CopyFileRangeResponse, error) {
 	m.ctrl.T.Helper()
@@ -1335,6 +1710,21 @@ func (mr *MockRawFileSystemServerMockRecorder) GetXAttr(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXAttr", reflect.TypeOf((*MockRawFileSystemServer)(nil).GetXAttr), arg0, arg1)
 }
 
+// Init mocks base method.
+func (m *MockRawFileSystemServer) Init(arg0 context.Context, arg1 *pb.InitRequest) (*pb.InitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Init", arg0, arg1)
+	ret0, _ := ret[0].(*pb.InitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Init indicates an expected call of Init.
+func (mr *MockRawFileSystemServerMockRecorder) Init(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockRawFileSystemServer)(nil).Init), arg0, arg1)
+}
+
 // Link mocks base method.
 func (m *MockRawFileSystemServer) Link(arg0 context.Context, arg1 *pb.LinkRequest) (*pb.LinkResponse, error) {
 	m.ctrl.T.Helper()
@@ -1350,6 +1740,21 @@ func (mr *MockRawFileSystemServerMockRecorder) Link(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Link", reflect.TypeOf((*MockRawFileSystemServer)(nil).Link), arg0, arg1)
 }
 
+// ListExports mocks base method.
+func (m *MockRawFileSystemServer) ListExports(arg0 context.Context, arg1 *pb.ListExportsRequest) (*pb.ListExportsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExports", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ListExportsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExports indicates an expected call of ListExports.
+func (mr *MockRawFileSystemServerMockRecorder) ListExports(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExports", reflect.TypeOf((*MockRawFileSystemServer)(nil).ListExports), arg0, arg1)
+}
+
 // ListXAttr mocks base method.
 func (m *MockRawFileSystemServer) ListXAttr(arg0 context.Context, arg1 *pb.ListXAttrRequest) (*pb.ListXAttrResponse, error) {
 	m.ctrl.T.Helper()
@@ -1425,6 +1830,20 @@ func (mr *MockRawFileSystemServerMockRecorder) Mknod(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mknod", reflect.TypeOf((*MockRawFileSystemServer)(nil).Mknod), arg0, arg1)
 }
 
+// Notify mocks base method.
+func (m *MockRawFileSystemServer) Notify(arg0 *pb.NotifyRequest, arg1 pb.RawFileSystem_NotifyServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockRawFileSystemServerMockRecorder) Notify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockRawFileSystemServer)(nil).Notify), arg0, arg1)
+}
+
 // Open mocks base method.
 func (m *MockRawFileSystemServer) Open(arg0 context.Context, arg1 *pb.OpenRequest) (*pb.OpenResponse, error) {
 	m.ctrl.T.Helper()
@@ -1722,6 +2141,20 @@ func (mr *MockRawFileSystemServerMockRecorder) Write(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockRawFileSystemServer)(nil).Write), arg0, arg1)
 }
 
+// WriteStream mocks base method.
+func (m *MockRawFileSystemServer) WriteStream(arg0 pb.RawFileSystem_WriteStreamServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteStream", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteStream indicates an expected call of WriteStream.
+func (mr *MockRawFileSystemServerMockRecorder) WriteStream(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteStream", reflect.TypeOf((*MockRawFileSystemServer)(nil).WriteStream), arg0)
+}
+
 // mustEmbedUnimplementedRawFileSystemServer mocks base method.
 func (m *MockRawFileSystemServer) mustEmbedUnimplementedRawFileSystemServer() {
 	m.ctrl.T.Helper()
@@ -1769,6 +2202,125 @@ func (mr *MockUnsafeRawFileSystemServerMockRecorder) mustEmbedUnimplementedRawFi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedRawFileSystemServer", reflect.TypeOf((*MockUnsafeRawFileSystemServer)(nil).mustEmbedUnimplementedRawFileSystemServer))
 }
 
+// MockRawFileSystem_NotifyServer is a mock of RawFileSystem_NotifyServer interface.
+type MockRawFileSystem_NotifyServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRawFileSystem_NotifyServerMockRecorder
+}
+
+// MockRawFileSystem_NotifyServerMockRecorder is the mock recorder for MockRawFileSystem_NotifyServer.
+type MockRawFileSystem_NotifyServerMockRecorder struct {
+	mock *MockRawFileSystem_NotifyServer
+}
+
+// NewMockRawFileSystem_NotifyServer creates a new mock instance.
+func NewMockRawFileSystem_NotifyServer(ctrl *gomock.Controller) *MockRawFileSystem_NotifyServer {
+	mock := &MockRawFileSystem_NotifyServer{ctrl: ctrl}
+	mock.recorder = &MockRawFileSystem_NotifyServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRawFileSystem_NotifyServer) EXPECT() *MockRawFileSystem_NotifyServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockRawFileSystem_NotifyServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockRawFileSystem_NotifyServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockRawFileSystem_NotifyServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockRawFileSystem_NotifyServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockRawFileSystem_NotifyServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockRawFileSystem_NotifyServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockRawFileSystem_NotifyServer) Send(arg0 *pb.NotifyResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockRawFileSystem_NotifyServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockRawFileSystem_NotifyServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockRawFileSystem_NotifyServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockRawFileSystem_NotifyServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockRawFileSystem_NotifyServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockRawFileSystem_NotifyServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockRawFileSystem_NotifyServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockRawFileSystem_NotifyServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockRawFileSystem_NotifyServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockRawFileSystem_NotifyServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockRawFileSystem_NotifyServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockRawFileSystem_NotifyServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockRawFileSystem_NotifyServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockRawFileSystem_NotifyServer)(nil).SetTrailer), arg0)
+}
+
 // MockRawFileSystem_ReadServer is a mock of RawFileSystem_ReadServer interface.
 type MockRawFileSystem_ReadServer struct {
 	ctrl     *gomock.Controller
@@ -1888,6 +2440,140 @@ func (mr *MockRawFileSystem_ReadServerMockRecorder) SetTrailer(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockRawFileSystem_ReadServer)(nil).SetTrailer), arg0)
 }
 
+// MockRawFileSystem_WriteStreamServer is a mock of RawFileSystem_WriteStreamServer interface.
+type MockRawFileSystem_WriteStreamServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRawFileSystem_WriteStreamServerMockRecorder
+}
+
+// MockRawFileSystem_WriteStreamServerMockRecorder is the mock recorder for MockRawFileSystem_WriteStreamServer.
+type MockRawFileSystem_WriteStreamServerMockRecorder struct {
+	mock *MockRawFileSystem_WriteStreamServer
+}
+
+// NewMockRawFileSystem_WriteStreamServer creates a new mock instance.
+func NewMockRawFileSystem_WriteStreamServer(ctrl *gomock.Controller) *MockRawFileSystem_WriteStreamServer {
+	mock := &MockRawFileSystem_WriteStreamServer{ctrl: ctrl}
+	mock.recorder = &MockRawFileSystem_WriteStreamServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRawFileSystem_WriteStreamServer) EXPECT() *MockRawFileSystem_WriteStreamServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockRawFileSystem_WriteStreamServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockRawFileSystem_WriteStreamServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockRawFileSystem_WriteStreamServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockRawFileSystem_WriteStreamServer) Recv() (*pb.WriteRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.WriteRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockRawFileSystem_WriteStreamServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockRawFileSystem_WriteStreamServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockRawFileSystem_WriteStreamServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockRawFileSystem_WriteStreamServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockRawFileSystem_WriteStreamServer)(nil).RecvMsg), m)
+}
+
+// SendAndClose mocks base method.
+func (m *MockRawFileSystem_WriteStreamServer) SendAndClose(arg0 *pb.WriteResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAndClose", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendAndClose indicates an expected call of SendAndClose.
+func (mr *MockRawFileSystem_WriteStreamServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockRawFileSystem_WriteStreamServer)(nil).SendAndClose), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockRawFileSystem_WriteStreamServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockRawFileSystem_WriteStreamServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockRawFileSystem_WriteStreamServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockRawFileSystem_WriteStreamServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockRawFileSystem_WriteStreamServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockRawFileSystem_WriteStreamServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockRawFileSystem_WriteStreamServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockRawFileSystem_WriteStreamServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockRawFileSystem_WriteStreamServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockRawFileSystem_WriteStreamServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockRawFileSystem_WriteStreamServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockRawFileSystem_WriteStreamServer)(nil).SetTrailer), arg0)
+}
+
 // MockRawFileSystem_ReadDirServer is a mock of RawFileSystem_ReadDirServer interface.
 type MockRawFileSystem_ReadDirServer struct {
 	ctrl     *gomock.Controller
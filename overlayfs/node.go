@@ -0,0 +1,519 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package overlayfs
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"golang.org/x/sys/unix"
+)
+
+// renameNoReplace is RENAME_NOREPLACE of Linux.
+const renameNoReplace = 0x1
+
+// node is an entry of the merged tree, found again by its path on every
+// operation.
+type node struct {
+	fs.Inode
+
+	overlay *overlay
+}
+
+var (
+	_ = (fs.NodeStatfser)((*node)(nil))
+	_ = (fs.NodeLookuper)((*node)(nil))
+	_ = (fs.NodeGetattrer)((*node)(nil))
+	_ = (fs.NodeSetattrer)((*node)(nil))
+	_ = (fs.NodeReadlinker)((*node)(nil))
+	_ = (fs.NodeOpener)((*node)(nil))
+	_ = (fs.NodeOpendirer)((*node)(nil))
+	_ = (fs.NodeReaddirer)((*node)(nil))
+	_ = (fs.NodeCreater)((*node)(nil))
+	_ = (fs.NodeMkdirer)((*node)(nil))
+	_ = (fs.NodeMknoder)((*node)(nil))
+	_ = (fs.NodeSymlinker)((*node)(nil))
+	_ = (fs.NodeLinker)((*node)(nil))
+	_ = (fs.NodeUnlinker)((*node)(nil))
+	_ = (fs.NodeRmdirer)((*node)(nil))
+	_ = (fs.NodeRenamer)((*node)(nil))
+	_ = (fs.NodeGetxattrer)((*node)(nil))
+	_ = (fs.NodeSetxattrer)((*node)(nil))
+	_ = (fs.NodeRemovexattrer)((*node)(nil))
+	_ = (fs.NodeListxattrer)((*node)(nil))
+)
+
+// name returns the path of n relative to the layers.
+func (n *node) name() string {
+	return n.Path(n.Root())
+}
+
+func (n *node) child(name string) string {
+	return path.Join(n.name(), name)
+}
+
+// newChild returns the inode of the entry name, which was just created
+// in the upper directory.
+func (n *node) newChild(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	var st syscall.Stat_t
+	if err := syscall.Lstat(filepath.Join(n.overlay.upper, name), &st); err != nil {
+		return nil, fs.ToErrno(err)
+	}
+	out.Attr.FromStat(&st)
+	return n.NewInode(ctx, &node{overlay: n.overlay}, idFromStat(&st)), 0
+}
+
+// preserveOwner gives p to the caller when running as root.
+func preserveOwner(ctx context.Context, p string) error {
+	if os.Geteuid() != 0 {
+		return nil
+	}
+	caller, ok := fuse.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return syscall.Lchown(p, int(caller.Uid), int(caller.Gid))
+}
+
+func (n *node) Statfs(ctx context.Context, out *fuse.StatfsOut) syscall.Errno {
+	var s syscall.Statfs_t
+	if err := syscall.Statfs(n.overlay.upper, &s); err != nil {
+		return fs.ToErrno(err)
+	}
+	out.FromStatfsT(&s)
+	return 0
+}
+
+func (n *node) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	if strings.HasPrefix(name, whiteoutPrefix) {
+		return nil, syscall.ENOENT
+	}
+	_, _, st, errno := n.overlay.resolve(n.child(name))
+	if errno != 0 {
+		return nil, errno
+	}
+	out.Attr.FromStat(st)
+	return n.NewInode(ctx, &node{overlay: n.overlay}, idFromStat(st)), 0
+}
+
+func (n *node) Getattr(ctx context.Context, f fs.FileHandle, out *fuse.AttrOut) syscall.Errno {
+	_, _, st, errno := n.overlay.resolve(n.name())
+	if errno != 0 {
+		// Unlinked files are still open.
+		if fga, ok := f.(fs.FileGetattrer); ok {
+			return fga.Getattr(ctx, out)
+		}
+		return errno
+	}
+	out.FromStat(st)
+	return 0
+}
+
+// Setattr copies the file up first, file handles opened before may still
+// point to the lower file so they are not used.
+func (n *node) Setattr(ctx context.Context, f fs.FileHandle, in *fuse.SetAttrIn, out *fuse.AttrOut) syscall.Errno {
+	o := n.overlay
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	name := n.name()
+	if errno := o.copyUp(name); errno != 0 {
+		return errno
+	}
+	p := filepath.Join(o.upper, name)
+
+	if m, ok := in.GetMode(); ok {
+		if err := syscall.Chmod(p, m); err != nil {
+			return fs.ToErrno(err)
+		}
+	}
+	uid, uok := in.GetUID()
+	gid, gok := in.GetGID()
+	if uok || gok {
+		suid, sgid := -1, -1
+		if uok {
+			suid = int(uid)
+		}
+		if gok {
+			sgid = int(gid)
+		}
+		if err := syscall.Lchown(p, suid, sgid); err != nil {
+			return fs.ToErrno(err)
+		}
+	}
+	mtime, mok := in.GetMTime()
+	atime, aok := in.GetATime()
+	if mok || aok {
+		ap, mp := &atime, &mtime
+		if !aok {
+			ap = nil
+		}
+		if !mok {
+			mp = nil
+		}
+		ts := []syscall.Timespec{fuse.UtimeToTimespec(ap), fuse.UtimeToTimespec(mp)}
+		if err := syscall.UtimesNano(p, ts); err != nil {
+			return fs.ToErrno(err)
+		}
+	}
+	if size, ok := in.GetSize(); ok {
+		if err := syscall.Truncate(p, int64(size)); err != nil {
+			return fs.ToErrno(err)
+		}
+	}
+
+	var st syscall.Stat_t
+	if err := syscall.Lstat(p, &st); err != nil {
+		return fs.ToErrno(err)
+	}
+	out.FromStat(&st)
+	return 0
+}
+
+func (n *node) Readlink(ctx context.Context) ([]byte, syscall.Errno) {
+	p, _, _, errno := n.overlay.resolve(n.name())
+	if errno != 0 {
+		return nil, errno
+	}
+	target, err := os.Readlink(p)
+	if err != nil {
+		return nil, fs.ToErrno(err)
+	}
+	return []byte(target), 0
+}
+
+// Open copies the file up when it is opened for writing.
+func (n *node) Open(ctx context.Context, flags uint32) (fs.FileHandle, uint32, syscall.Errno) {
+	o := n.overlay
+	name := n.name()
+	if flags&(syscall.O_WRONLY|syscall.O_RDWR|syscall.O_TRUNC|syscall.O_APPEND) != 0 {
+		o.mu.Lock()
+		errno := o.copyUp(name)
+		o.mu.Unlock()
+		if errno != 0 {
+			return nil, 0, errno
+		}
+	}
+	p, _, _, errno := o.resolve(name)
+	if errno != 0 {
+		return nil, 0, errno
+	}
+	fd, err := syscall.Open(p, int(flags&^syscall.O_APPEND), 0)
+	if err != nil {
+		return nil, 0, fs.ToErrno(err)
+	}
+	return fs.NewLoopbackFile(fd), 0, 0
+}
+
+func (n *node) Opendir(ctx context.Context) syscall.Errno {
+	_, _, st, errno := n.overlay.resolve(n.name())
+	if errno != 0 {
+		return errno
+	}
+	if uint32(st.Mode)&syscall.S_IFMT != syscall.S_IFDIR {
+		return syscall.ENOTDIR
+	}
+	return 0
+}
+
+func (n *node) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	entries, errno := n.overlay.readdir(n.name())
+	if errno != 0 {
+		return nil, errno
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *node) Create(ctx context.Context, name string, flags uint32, mode uint32, out *fuse.EntryOut) (*fs.Inode, fs.FileHandle, uint32, syscall.Errno) {
+	o := n.overlay
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	name = n.child(name)
+	p, errno := o.prepare(name)
+	if errno != 0 {
+		return nil, nil, 0, errno
+	}
+	fd, err := syscall.Open(p, int(flags|syscall.O_CREAT)&^syscall.O_APPEND, mode)
+	if err != nil {
+		return nil, nil, 0, fs.ToErrno(err)
+	}
+	preserveOwner(ctx, p)
+	inode, errno := n.newChild(ctx, name, out)
+	if errno != 0 {
+		syscall.Close(fd)
+		return nil, nil, 0, errno
+	}
+	return inode, fs.NewLoopbackFile(fd), 0, 0
+}
+
+func (n *node) Mkdir(ctx context.Context, name string, mode uint32, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	o := n.overlay
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	name = n.child(name)
+	p, errno := o.prepare(name)
+	if errno != 0 {
+		return nil, errno
+	}
+	if err := syscall.Mkdir(p, mode); err != nil {
+		return nil, fs.ToErrno(err)
+	}
+	preserveOwner(ctx, p)
+	// A lower directory removed before must not show through.
+	if errno := o.makeOpaque(name); errno != 0 {
+		return nil, errno
+	}
+	return n.newChild(ctx, name, out)
+}
+
+func (n *node) Mknod(ctx context.Context, name string, mode uint32, dev uint32, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	o := n.overlay
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	name = n.child(name)
+	p, errno := o.prepare(name)
+	if errno != 0 {
+		return nil, errno
+	}
+	if err := syscall.Mknod(p, mode, int(dev)); err != nil {
+		return nil, fs.ToErrno(err)
+	}
+	preserveOwner(ctx, p)
+	return n.newChild(ctx, name, out)
+}
+
+func (n *node) Symlink(ctx context.Context, target, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	o := n.overlay
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	name = n.child(name)
+	p, errno := o.prepare(name)
+	if errno != 0 {
+		return nil, errno
+	}
+	if err := syscall.Symlink(target, p); err != nil {
+		return nil, fs.ToErrno(err)
+	}
+	preserveOwner(ctx, p)
+	return n.newChild(ctx, name, out)
+}
+
+func (n *node) Link(ctx context.Context, target fs.InodeEmbedder, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	t, ok := target.(*node)
+	if !ok {
+		return nil, syscall.EXDEV
+	}
+	o := n.overlay
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	name = n.child(name)
+	p, errno := o.prepare(name)
+	if errno != 0 {
+		return nil, errno
+	}
+	targetName := t.name()
+	if errno := o.copyUp(targetName); errno != 0 {
+		return nil, errno
+	}
+	if err := syscall.Link(filepath.Join(o.upper, targetName), p); err != nil {
+		return nil, fs.ToErrno(err)
+	}
+	return n.newChild(ctx, name, out)
+}
+
+// remove drops name from the upper directory and hides the lower entry
+// with a whiteout. o.mu must be held.
+func (o *overlay) remove(name string, upper bool, removeUpper func(string) error) syscall.Errno {
+	lower := o.lowerHas(name)
+	if upper {
+		if err := removeUpper(filepath.Join(o.upper, name)); err != nil {
+			return fs.ToErrno(err)
+		}
+	}
+	if lower {
+		return o.whiteout(name)
+	}
+	return 0
+}
+
+func (n *node) Unlink(ctx context.Context, name string) syscall.Errno {
+	o := n.overlay
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	name = n.child(name)
+	_, upper, st, errno := o.resolve(name)
+	if errno != 0 {
+		return errno
+	}
+	if uint32(st.Mode)&syscall.S_IFMT == syscall.S_IFDIR {
+		return syscall.EISDIR
+	}
+	return o.remove(name, upper, os.Remove)
+}
+
+func (n *node) Rmdir(ctx context.Context, name string) syscall.Errno {
+	o := n.overlay
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	name = n.child(name)
+	_, upper, st, errno := o.resolve(name)
+	if errno != 0 {
+		return errno
+	}
+	if uint32(st.Mode)&syscall.S_IFMT != syscall.S_IFDIR {
+		return syscall.ENOTDIR
+	}
+	entries, errno := o.readdir(name)
+	if errno != 0 {
+		return errno
+	}
+	if len(entries) > 0 {
+		return syscall.ENOTEMPTY
+	}
+	// The upper directory only holds whiteouts.
+	return o.remove(name, upper, os.RemoveAll)
+}
+
+// Rename moves files after copying them up. Directories which are
+// merged with a lower one fail with EXDEV, like overlayfs without
+// redirect_dir, and tools fall back to copying them.
+func (n *node) Rename(ctx context.Context, name string, newParent fs.InodeEmbedder, newName string, flags uint32) syscall.Errno {
+	np, ok := newParent.(*node)
+	if !ok {
+		return syscall.EXDEV
+	}
+	if flags&^renameNoReplace != 0 {
+		return syscall.EINVAL
+	}
+	if strings.HasPrefix(newName, whiteoutPrefix) {
+		return syscall.EPERM
+	}
+	o := n.overlay
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	src, dst := n.child(name), np.child(newName)
+	_, srcUpper, srcSt, errno := o.resolve(src)
+	if errno != 0 {
+		return errno
+	}
+	srcDir := uint32(srcSt.Mode)&syscall.S_IFMT == syscall.S_IFDIR
+	srcLower := o.lowerHas(src)
+	if srcDir && srcLower && (!srcUpper || !exists(filepath.Join(o.upper, src, opaqueMarker))) {
+		return syscall.EXDEV
+	}
+
+	if _, dstUpper, dstSt, errno := o.resolve(dst); errno == 0 {
+		if flags&renameNoReplace != 0 {
+			return syscall.EEXIST
+		}
+		dstDir := uint32(dstSt.Mode)&syscall.S_IFMT == syscall.S_IFDIR
+		switch {
+		case srcDir && !dstDir:
+			return syscall.ENOTDIR
+		case !srcDir && dstDir:
+			return syscall.EISDIR
+		case dstDir:
+			entries, errno := o.readdir(dst)
+			if errno != 0 {
+				return errno
+			}
+			if len(entries) > 0 {
+				return syscall.ENOTEMPTY
+			}
+			if dstUpper {
+				if err := os.RemoveAll(filepath.Join(o.upper, dst)); err != nil {
+					return fs.ToErrno(err)
+				}
+			}
+		}
+	}
+
+	if errno := o.copyUp(src); errno != 0 {
+		return errno
+	}
+	if errno := o.clearWhiteout(dst); errno != 0 {
+		return errno
+	}
+	if err := syscall.Rename(filepath.Join(o.upper, src), filepath.Join(o.upper, dst)); err != nil {
+		return fs.ToErrno(err)
+	}
+	if srcDir {
+		if errno := o.makeOpaque(dst); errno != 0 {
+			return errno
+		}
+	}
+	if srcLower {
+		return o.whiteout(src)
+	}
+	return 0
+}
+
+func (n *node) Getxattr(ctx context.Context, attr string, dest []byte) (uint32, syscall.Errno) {
+	p, _, _, errno := n.overlay.resolve(n.name())
+	if errno != 0 {
+		return 0, errno
+	}
+	sz, err := unix.Lgetxattr(p, attr, dest)
+	return uint32(sz), fs.ToErrno(err)
+}
+
+func (n *node) Listxattr(ctx context.Context, dest []byte) (uint32, syscall.Errno) {
+	p, _, _, errno := n.overlay.resolve(n.name())
+	if errno != 0 {
+		return 0, errno
+	}
+	sz, err := unix.Llistxattr(p, dest)
+	return uint32(sz), fs.ToErrno(err)
+}
+
+func (n *node) Setxattr(ctx context.Context, attr string, data []byte, flags uint32) syscall.Errno {
+	o := n.overlay
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	name := n.name()
+	if errno := o.copyUp(name); errno != 0 {
+		return errno
+	}
+	return fs.ToErrno(unix.Lsetxattr(filepath.Join(o.upper, name), attr, data, int(flags)))
+}
+
+func (n *node) Removexattr(ctx context.Context, attr string) syscall.Errno {
+	o := n.overlay
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	name := n.name()
+	if errno := o.copyUp(name); errno != 0 {
+		return errno
+	}
+	return fs.ToErrno(unix.Lremovexattr(filepath.Join(o.upper, name), attr))
+}
@@ -0,0 +1,342 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package overlayfs merges a read-only lower directory with a writable
+// upper one, like the overlay file system of Linux. Files are copied up
+// to the upper directory when they are first changed, and removed lower
+// entries are hidden by whiteouts.
+//
+// Whiteouts are empty files named ".wh.<name>" in the upper directory
+// and a directory hiding all of its lower entries holds a ".wh..wh..opq"
+// file, as in AUFS. Unlike the character devices of overlayfs they do
+// not need privileges to be created.
+package overlayfs
+
+import (
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+const (
+	whiteoutPrefix = ".wh."
+	opaqueMarker   = whiteoutPrefix + whiteoutPrefix + ".opq"
+)
+
+// overlay is the state shared by the nodes.
+type overlay struct {
+	lower string
+	upper string
+
+	// mu serializes the changes of the upper directory, so that the
+	// copy-ups and whiteouts of concurrent requests do not race.
+	mu sync.Mutex
+}
+
+// NewRoot returns the root of a file system merging lower and upper,
+// to be served with fs.NewNodeFS. The lower directory is never changed.
+func NewRoot(lower, upper string) (fs.InodeEmbedder, error) {
+	o := &overlay{}
+	for _, d := range []struct {
+		dir *string
+		arg string
+	}{{&o.lower, lower}, {&o.upper, upper}} {
+		abs, err := filepath.Abs(d.arg)
+		if err != nil {
+			return nil, err
+		}
+		st, err := os.Stat(abs)
+		if err != nil {
+			return nil, err
+		}
+		if !st.IsDir() {
+			return nil, &os.PathError{Op: "overlay", Path: abs, Err: syscall.ENOTDIR}
+		}
+		*d.dir = abs
+	}
+	return &node{overlay: o}, nil
+}
+
+func exists(p string) bool {
+	var st syscall.Stat_t
+	return syscall.Lstat(p, &st) == nil
+}
+
+func parentName(name string) string {
+	if dir := path.Dir(name); dir != "." {
+		return dir
+	}
+	return ""
+}
+
+func whiteoutName(name string) string {
+	return path.Join(parentName(name), whiteoutPrefix+path.Base(name))
+}
+
+// lowerVisible tells whether the lower entry name is hidden neither by
+// a whiteout nor by an opaque directory.
+func (o *overlay) lowerVisible(name string) bool {
+	dir := ""
+	for _, part := range strings.Split(name, "/") {
+		if part == "" {
+			continue
+		}
+		if exists(filepath.Join(o.upper, dir, opaqueMarker)) || exists(filepath.Join(o.upper, dir, whiteoutPrefix+part)) {
+			return false
+		}
+		dir = path.Join(dir, part)
+	}
+	return true
+}
+
+// lowerHas tells whether the lower entry name exists and is visible.
+func (o *overlay) lowerHas(name string) bool {
+	return exists(filepath.Join(o.lower, name)) && o.lowerVisible(name)
+}
+
+// resolve returns the path of name in the upper directory, or else in
+// the lower one.
+func (o *overlay) resolve(name string) (string, bool, *syscall.Stat_t, syscall.Errno) {
+	var st syscall.Stat_t
+	p := filepath.Join(o.upper, name)
+	err := syscall.Lstat(p, &st)
+	if err == nil {
+		return p, true, &st, 0
+	}
+	if err != syscall.ENOENT && err != syscall.ENOTDIR {
+		return "", false, nil, fs.ToErrno(err)
+	}
+	if !o.lowerVisible(name) {
+		return "", false, nil, syscall.ENOENT
+	}
+	p = filepath.Join(o.lower, name)
+	if err := syscall.Lstat(p, &st); err != nil {
+		return "", false, nil, fs.ToErrno(err)
+	}
+	return p, false, &st, 0
+}
+
+// copyUp copies name and the directories above it to the upper
+// directory, o.mu must be held.
+func (o *overlay) copyUp(name string) syscall.Errno {
+	up := filepath.Join(o.upper, name)
+	if name == "" || exists(up) {
+		return 0
+	}
+	if errno := o.copyUp(parentName(name)); errno != 0 {
+		return errno
+	}
+	if !o.lowerVisible(name) {
+		return syscall.ENOENT
+	}
+	low := filepath.Join(o.lower, name)
+	var st syscall.Stat_t
+	if err := syscall.Lstat(low, &st); err != nil {
+		return fs.ToErrno(err)
+	}
+
+	var err error
+	mode := uint32(st.Mode)
+	switch mode & syscall.S_IFMT {
+	case syscall.S_IFDIR:
+		err = syscall.Mkdir(up, mode&07777)
+	case syscall.S_IFREG:
+		err = copyFile(low, up)
+	case syscall.S_IFLNK:
+		var target string
+		if target, err = os.Readlink(low); err == nil {
+			err = os.Symlink(target, up)
+		}
+	default:
+		err = syscall.Mknod(up, mode, int(st.Rdev))
+	}
+	if err != nil {
+		return fs.ToErrno(err)
+	}
+	return copyAttr(up, &st)
+}
+
+// copyFile copies a regular file through a hidden temporary file, so
+// that a failed copy never shows up.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.CreateTemp(filepath.Dir(dst), whiteoutPrefix+"copyup.")
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err == nil {
+		err = out.Sync()
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(out.Name(), dst)
+	}
+	if err != nil {
+		os.Remove(out.Name())
+	}
+	return err
+}
+
+// copyAttr gives p the owner, mode and times of st. Owners are only
+// kept when running as root.
+func copyAttr(p string, st *syscall.Stat_t) syscall.Errno {
+	if os.Geteuid() == 0 {
+		if err := syscall.Lchown(p, int(st.Uid), int(st.Gid)); err != nil {
+			return fs.ToErrno(err)
+		}
+	}
+	if uint32(st.Mode)&syscall.S_IFMT == syscall.S_IFLNK {
+		return 0
+	}
+	if err := syscall.Chmod(p, uint32(st.Mode)&07777); err != nil {
+		return fs.ToErrno(err)
+	}
+	var attr fuse.Attr
+	attr.FromStat(st)
+	ts := []syscall.Timespec{
+		syscall.NsecToTimespec(time.Unix(int64(attr.Atime), int64(attr.Atimensec)).UnixNano()),
+		syscall.NsecToTimespec(time.Unix(int64(attr.Mtime), int64(attr.Mtimensec)).UnixNano()),
+	}
+	return fs.ToErrno(syscall.UtimesNano(p, ts))
+}
+
+// whiteout hides the lower entry name, o.mu must be held.
+func (o *overlay) whiteout(name string) syscall.Errno {
+	if errno := o.copyUp(parentName(name)); errno != 0 {
+		return errno
+	}
+	fd, err := syscall.Open(filepath.Join(o.upper, whiteoutName(name)), syscall.O_CREAT|syscall.O_WRONLY, 0644)
+	if err != nil {
+		return fs.ToErrno(err)
+	}
+	syscall.Close(fd)
+	return 0
+}
+
+// prepare readies the creation of name in the upper directory: its
+// parent is copied up and its whiteout removed. o.mu must be held.
+func (o *overlay) prepare(name string) (string, syscall.Errno) {
+	if strings.HasPrefix(path.Base(name), whiteoutPrefix) {
+		return "", syscall.EPERM
+	}
+	if _, _, _, errno := o.resolve(name); errno == 0 {
+		return "", syscall.EEXIST
+	}
+	if errno := o.clearWhiteout(name); errno != 0 {
+		return "", errno
+	}
+	return filepath.Join(o.upper, name), 0
+}
+
+// clearWhiteout copies up the parent of name and removes its whiteout,
+// o.mu must be held.
+func (o *overlay) clearWhiteout(name string) syscall.Errno {
+	if errno := o.copyUp(parentName(name)); errno != 0 {
+		return errno
+	}
+	if err := os.Remove(filepath.Join(o.upper, whiteoutName(name))); err != nil && !os.IsNotExist(err) {
+		return fs.ToErrno(err)
+	}
+	return 0
+}
+
+// makeOpaque hides the lower directory name under the upper one, which
+// replaced it. o.mu must be held.
+func (o *overlay) makeOpaque(name string) syscall.Errno {
+	if !exists(filepath.Join(o.lower, name)) {
+		return 0
+	}
+	fd, err := syscall.Open(filepath.Join(o.upper, name, opaqueMarker), syscall.O_CREAT|syscall.O_WRONLY, 0644)
+	if err != nil {
+		return fs.ToErrno(err)
+	}
+	syscall.Close(fd)
+	return 0
+}
+
+// readdir merges the entries of the directory name.
+func (o *overlay) readdir(name string) ([]fuse.DirEntry, syscall.Errno) {
+	var entries []fuse.DirEntry
+	seen := make(map[string]bool)
+	opaque := false
+
+	add := func(dir string) syscall.Errno {
+		list, err := os.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) || err.(*os.PathError).Err == syscall.ENOTDIR {
+				return 0
+			}
+			return fs.ToErrno(err)
+		}
+		for _, e := range list {
+			switch n := e.Name(); {
+			case n == opaqueMarker:
+				opaque = true
+			case strings.HasPrefix(n, whiteoutPrefix):
+				seen[strings.TrimPrefix(n, whiteoutPrefix)] = true
+			case !seen[n]:
+				seen[n] = true
+				info, err := e.Info()
+				if err != nil {
+					continue
+				}
+				st := info.Sys().(*syscall.Stat_t)
+				entries = append(entries, fuse.DirEntry{
+					Name: n,
+					Mode: uint32(st.Mode),
+					Ino:  idFromStat(st).Ino,
+				})
+			}
+		}
+		return 0
+	}
+
+	if errno := add(filepath.Join(o.upper, name)); errno != 0 {
+		return nil, errno
+	}
+	if !opaque && o.lowerVisible(name) {
+		if errno := add(filepath.Join(o.lower, name)); errno != 0 {
+			return nil, errno
+		}
+	}
+	return entries, 0
+}
+
+// idFromStat mixes the device into the inode number, as the layers may
+// be different file systems.
+func idFromStat(st *syscall.Stat_t) fs.StableAttr {
+	dev := uint64(st.Dev)
+	return fs.StableAttr{
+		Mode: uint32(st.Mode),
+		Gen:  1,
+		Ino:  (dev<<32 | dev>>32) ^ uint64(st.Ino),
+	}
+}
@@ -0,0 +1,159 @@
+package overlayfs_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chiyutianyi/grpcfuse/overlayfs"
+)
+
+type testFS struct {
+	t     *testing.T
+	lower string
+	upper string
+	root  fs.InodeEmbedder
+	raw   fuse.RawFileSystem
+}
+
+func newTestFS(t *testing.T) *testFS {
+	tfs := &testFS{t: t, lower: t.TempDir(), upper: t.TempDir()}
+	for name, data := range map[string]string{"dir/a": "lower a", "dir/b": "lower b", "dir/sub/x": "x", "top": "top"} {
+		p := filepath.Join(tfs.lower, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, []byte(data), 0644))
+	}
+	var err error
+	tfs.root, err = overlayfs.NewRoot(tfs.lower, tfs.upper)
+	require.NoError(t, err)
+	tfs.raw = fs.NewNodeFS(tfs.root, &fs.Options{})
+	return tfs
+}
+
+func (tfs *testFS) lookup(name string) (*fuse.EntryOut, fuse.Status) {
+	parent := uint64(fuse.FUSE_ROOT_ID)
+	var out fuse.EntryOut
+	for _, part := range strings.Split(name, "/") {
+		if st := tfs.raw.Lookup(nil, &fuse.InHeader{NodeId: parent}, part, &out); st != fuse.OK {
+			return nil, st
+		}
+		parent = out.NodeId
+	}
+	return &out, fuse.OK
+}
+
+func (tfs *testFS) node(name string) uint64 {
+	out, st := tfs.lookup(name)
+	require.Equal(tfs.t, fuse.OK, st, name)
+	return out.NodeId
+}
+
+func (tfs *testFS) list(dir string) []string {
+	inode := tfs.root.EmbeddedInode()
+	if dir != "" {
+		tfs.node(dir)
+		for _, part := range strings.Split(dir, "/") {
+			inode = inode.GetChild(part)
+		}
+	}
+	stream, errno := inode.Operations().(fs.NodeReaddirer).Readdir(context.Background())
+	require.Zero(tfs.t, errno)
+	var names []string
+	for stream.HasNext() {
+		e, errno := stream.Next()
+		require.Zero(tfs.t, errno)
+		names = append(names, e.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (tfs *testFS) readFile(layer, name string) string {
+	data, err := os.ReadFile(filepath.Join(layer, name))
+	require.NoError(tfs.t, err)
+	return string(data)
+}
+
+func TestCopyUp(t *testing.T) {
+	tfs := newTestFS(t)
+
+	a := tfs.node("dir/a")
+	var open fuse.OpenOut
+	require.Equal(t, fuse.OK, tfs.raw.Open(nil, &fuse.OpenIn{InHeader: fuse.InHeader{NodeId: a}, Flags: syscall.O_RDWR}, &open))
+	_, st := tfs.raw.Write(nil, &fuse.WriteIn{InHeader: fuse.InHeader{NodeId: a}, Fh: open.Fh, Size: 5}, []byte("upper"))
+	require.Equal(t, fuse.OK, st)
+	tfs.raw.Release(nil, &fuse.ReleaseIn{InHeader: fuse.InHeader{NodeId: a}, Fh: open.Fh})
+	require.Equal(t, "upper a", tfs.readFile(tfs.upper, "dir/a"))
+	require.Equal(t, "lower a", tfs.readFile(tfs.lower, "dir/a"))
+
+	in := &fuse.SetAttrIn{}
+	in.NodeId, in.Valid, in.Mode = tfs.node("top"), fuse.FATTR_MODE, 0600
+	var attr fuse.AttrOut
+	require.Equal(t, fuse.OK, tfs.raw.SetAttr(nil, in, &attr))
+	require.Equal(t, uint32(syscall.S_IFREG|0600), attr.Mode)
+	st2, err := os.Stat(filepath.Join(tfs.lower, "top"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0644), st2.Mode())
+	require.Equal(t, "top", tfs.readFile(tfs.upper, "top"))
+
+	require.Equal(t, []string{"a", "b", "sub"}, tfs.list("dir"))
+}
+
+func TestWhiteouts(t *testing.T) {
+	tfs := newTestFS(t)
+	dir := &fuse.InHeader{NodeId: tfs.node("dir")}
+
+	require.Equal(t, fuse.OK, tfs.raw.Unlink(nil, dir, "b"))
+	_, st := tfs.lookup("dir/b")
+	require.Equal(t, fuse.ENOENT, st)
+	require.Equal(t, "lower b", tfs.readFile(tfs.lower, "dir/b"))
+	require.Equal(t, []string{"a", "sub"}, tfs.list("dir"))
+
+	require.Equal(t, fuse.Status(syscall.ENOTEMPTY), tfs.raw.Rmdir(nil, dir, "sub"))
+	require.Equal(t, fuse.OK, tfs.raw.Unlink(nil, &fuse.InHeader{NodeId: tfs.node("dir/sub")}, "x"))
+	require.Equal(t, fuse.OK, tfs.raw.Rmdir(nil, dir, "sub"))
+	require.Equal(t, []string{"a"}, tfs.list("dir"))
+
+	// The new directory is opaque, the lower one does not show through.
+	var entry fuse.EntryOut
+	require.Equal(t, fuse.OK, tfs.raw.Mkdir(nil, &fuse.MkdirIn{InHeader: *dir, Mode: 0755}, "sub", &entry))
+	require.Empty(t, tfs.list("dir/sub"))
+	_, st = tfs.lookup("dir/sub/x")
+	require.Equal(t, fuse.ENOENT, st)
+
+	var out fuse.CreateOut
+	require.Equal(t, fuse.OK, tfs.raw.Create(nil, &fuse.CreateIn{InHeader: *dir, Mode: 0644}, "b", &out))
+	require.Equal(t, uint64(0), out.Size)
+	require.Equal(t, []string{"a", "b", "sub"}, tfs.list("dir"))
+
+	require.Equal(t, fuse.EPERM, tfs.raw.Create(nil, &fuse.CreateIn{InHeader: *dir, Mode: 0644}, ".wh.a", &out))
+	_, st = tfs.lookup("dir/.wh.b")
+	require.Equal(t, fuse.ENOENT, st)
+}
+
+func TestRename(t *testing.T) {
+	tfs := newTestFS(t)
+	root := fuse.InHeader{NodeId: fuse.FUSE_ROOT_ID}
+	tfs.node("top")
+
+	require.Equal(t, fuse.OK, tfs.raw.Rename(nil, &fuse.RenameIn{InHeader: root, Newdir: fuse.FUSE_ROOT_ID}, "top", "moved"))
+	require.Equal(t, []string{"dir", "moved"}, tfs.list(""))
+	require.Equal(t, "top", tfs.readFile(tfs.upper, "moved"))
+	require.Equal(t, "top", tfs.readFile(tfs.lower, "top"))
+
+	tfs.node("dir")
+	require.Equal(t, fuse.Status(syscall.EXDEV), tfs.raw.Rename(nil, &fuse.RenameIn{InHeader: root, Newdir: fuse.FUSE_ROOT_ID}, "dir", "newdir"))
+
+	var entry fuse.EntryOut
+	require.Equal(t, fuse.OK, tfs.raw.Mkdir(nil, &fuse.MkdirIn{InHeader: root, Mode: 0755}, "new", &entry))
+	require.Equal(t, fuse.OK, tfs.raw.Rename(nil, &fuse.RenameIn{InHeader: root, Newdir: fuse.FUSE_ROOT_ID}, "new", "newdir"))
+	require.Equal(t, []string{"dir", "moved", "newdir"}, tfs.list(""))
+}
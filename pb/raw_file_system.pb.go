@@ -36,6 +36,129 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Feature is a bit of the features exchanged by Init.
+type Feature int32
+
+const (
+	Feature_FEATURE_NONE Feature = 0
+	// WriteStream is served.
+	Feature_FEATURE_WRITE_STREAM Feature = 1
+	// BatchForget is served.
+	Feature_FEATURE_BATCH_FORGET Feature = 2
+	// ReadDirPlus sends the EntryOut of every entry.
+	Feature_FEATURE_READDIRPLUS_ENTRIES Feature = 4
+	// Notify is served.
+	Feature_FEATURE_NOTIFY Feature = 8
+	// GetLk, SetLk and SetLkw are served.
+	Feature_FEATURE_LOCKS Feature = 16
+	// Payloads may be compressed with one of the compressors of Init.
+	Feature_FEATURE_COMPRESSION Feature = 32
+	// The data of Read and Write may carry checksums.
+	Feature_FEATURE_CHECKSUMS Feature = 64
+)
+
+// Enum value maps for Feature.
+var (
+	Feature_name = map[int32]string{
+		0:  "FEATURE_NONE",
+		1:  "FEATURE_WRITE_STREAM",
+		2:  "FEATURE_BATCH_FORGET",
+		4:  "FEATURE_READDIRPLUS_ENTRIES",
+		8:  "FEATURE_NOTIFY",
+		16: "FEATURE_LOCKS",
+		32: "FEATURE_COMPRESSION",
+		64: "FEATURE_CHECKSUMS",
+	}
+	Feature_value = map[string]int32{
+		"FEATURE_NONE":                0,
+		"FEATURE_WRITE_STREAM":        1,
+		"FEATURE_BATCH_FORGET":        2,
+		"FEATURE_READDIRPLUS_ENTRIES": 4,
+		"FEATURE_NOTIFY":              8,
+		"FEATURE_LOCKS":               16,
+		"FEATURE_COMPRESSION":         32,
+		"FEATURE_CHECKSUMS":           64,
+	}
+)
+
+func (x Feature) Enum() *Feature {
+	p := new(Feature)
+	*p = x
+	return p
+}
+
+func (x Feature) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Feature) Descriptor() protoreflect.EnumDescriptor {
+	return file_raw_file_system_proto_enumTypes[0].Descriptor()
+}
+
+func (Feature) Type() protoreflect.EnumType {
+	return &file_raw_file_system_proto_enumTypes[0]
+}
+
+func (x Feature) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Feature.Descriptor instead.
+func (Feature) EnumDescriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{0}
+}
+
+// ChecksumType selects the checksum protecting the data of Read and
+// Write.
+type ChecksumType int32
+
+const (
+	ChecksumType_CHECKSUM_NONE   ChecksumType = 0
+	ChecksumType_CHECKSUM_CRC32C ChecksumType = 1
+	ChecksumType_CHECKSUM_XXHASH ChecksumType = 2
+)
+
+// Enum value maps for ChecksumType.
+var (
+	ChecksumType_name = map[int32]string{
+		0: "CHECKSUM_NONE",
+		1: "CHECKSUM_CRC32C",
+		2: "CHECKSUM_XXHASH",
+	}
+	ChecksumType_value = map[string]int32{
+		"CHECKSUM_NONE":   0,
+		"CHECKSUM_CRC32C": 1,
+		"CHECKSUM_XXHASH": 2,
+	}
+)
+
+func (x ChecksumType) Enum() *ChecksumType {
+	p := new(ChecksumType)
+	*p = x
+	return p
+}
+
+func (x ChecksumType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChecksumType) Descriptor() protoreflect.EnumDescriptor {
+	return file_raw_file_system_proto_enumTypes[1].Descriptor()
+}
+
+func (ChecksumType) Type() protoreflect.EnumType {
+	return &file_raw_file_system_proto_enumTypes[1]
+}
+
+func (x ChecksumType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChecksumType.Descriptor instead.
+func (ChecksumType) EnumDescriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{1}
+}
+
 type StringRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -121,17 +244,20 @@ func (x *StringResponse) GetValue() string {
 	return ""
 }
 
-type LookupRequest struct {
+// KernelSettings is the FUSE_INIT message of the kernel of a client.
+type KernelSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *InHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Name   string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Major        uint32 `protobuf:"varint,1,opt,name=major,proto3" json:"major,omitempty"`
+	Minor        uint32 `protobuf:"varint,2,opt,name=minor,proto3" json:"minor,omitempty"`
+	MaxReadahead uint32 `protobuf:"varint,3,opt,name=max_readahead,json=maxReadahead,proto3" json:"max_readahead,omitempty"`
+	Flags        uint32 `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
 }
 
-func (x *LookupRequest) Reset() {
-	*x = LookupRequest{}
+func (x *KernelSettings) Reset() {
+	*x = KernelSettings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_raw_file_system_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -139,13 +265,13 @@ func (x *LookupRequest) Reset() {
 	}
 }
 
-func (x *LookupRequest) String() string {
+func (x *KernelSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupRequest) ProtoMessage() {}
+func (*KernelSettings) ProtoMessage() {}
 
-func (x *LookupRequest) ProtoReflect() protoreflect.Message {
+func (x *KernelSettings) ProtoReflect() protoreflect.Message {
 	mi := &file_raw_file_system_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -157,51 +283,240 @@ func (x *LookupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupRequest.ProtoReflect.Descriptor instead.
-func (*LookupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use KernelSettings.ProtoReflect.Descriptor instead.
+func (*KernelSettings) Descriptor() ([]byte, []int) {
 	return file_raw_file_system_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *LookupRequest) GetHeader() *InHeader {
+func (x *KernelSettings) GetMajor() uint32 {
 	if x != nil {
-		return x.Header
+		return x.Major
+	}
+	return 0
+}
+
+func (x *KernelSettings) GetMinor() uint32 {
+	if x != nil {
+		return x.Minor
+	}
+	return 0
+}
+
+func (x *KernelSettings) GetMaxReadahead() uint32 {
+	if x != nil {
+		return x.MaxReadahead
+	}
+	return 0
+}
+
+func (x *KernelSettings) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+// MountOptions are the options a client mounted the file system with.
+type MountOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllowOther               bool     `protobuf:"varint,1,opt,name=allow_other,json=allowOther,proto3" json:"allow_other,omitempty"`
+	Options                  []string `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	MaxBackground            int32    `protobuf:"varint,3,opt,name=max_background,json=maxBackground,proto3" json:"max_background,omitempty"`
+	MaxWrite                 int32    `protobuf:"varint,4,opt,name=max_write,json=maxWrite,proto3" json:"max_write,omitempty"`
+	MaxReadAhead             int32    `protobuf:"varint,5,opt,name=max_read_ahead,json=maxReadAhead,proto3" json:"max_read_ahead,omitempty"`
+	IgnoreSecurityLabels     bool     `protobuf:"varint,6,opt,name=ignore_security_labels,json=ignoreSecurityLabels,proto3" json:"ignore_security_labels,omitempty"`
+	RememberInodes           bool     `protobuf:"varint,7,opt,name=remember_inodes,json=rememberInodes,proto3" json:"remember_inodes,omitempty"`
+	FsName                   string   `protobuf:"bytes,8,opt,name=fs_name,json=fsName,proto3" json:"fs_name,omitempty"`
+	Name                     string   `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
+	SingleThreaded           bool     `protobuf:"varint,10,opt,name=single_threaded,json=singleThreaded,proto3" json:"single_threaded,omitempty"`
+	DisableXattrs            bool     `protobuf:"varint,11,opt,name=disable_xattrs,json=disableXattrs,proto3" json:"disable_xattrs,omitempty"`
+	EnableLocks              bool     `protobuf:"varint,12,opt,name=enable_locks,json=enableLocks,proto3" json:"enable_locks,omitempty"`
+	ExplicitDataCacheControl bool     `protobuf:"varint,13,opt,name=explicit_data_cache_control,json=explicitDataCacheControl,proto3" json:"explicit_data_cache_control,omitempty"`
+	SyncRead                 bool     `protobuf:"varint,14,opt,name=sync_read,json=syncRead,proto3" json:"sync_read,omitempty"`
+	DirectMount              bool     `protobuf:"varint,15,opt,name=direct_mount,json=directMount,proto3" json:"direct_mount,omitempty"`
+	EnableAcl                bool     `protobuf:"varint,16,opt,name=enable_acl,json=enableAcl,proto3" json:"enable_acl,omitempty"`
+}
+
+func (x *MountOptions) Reset() {
+	*x = MountOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raw_file_system_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MountOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MountOptions) ProtoMessage() {}
+
+func (x *MountOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_raw_file_system_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MountOptions.ProtoReflect.Descriptor instead.
+func (*MountOptions) Descriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MountOptions) GetAllowOther() bool {
+	if x != nil {
+		return x.AllowOther
+	}
+	return false
+}
+
+func (x *MountOptions) GetOptions() []string {
+	if x != nil {
+		return x.Options
 	}
 	return nil
 }
 
-func (x *LookupRequest) GetName() string {
+func (x *MountOptions) GetMaxBackground() int32 {
+	if x != nil {
+		return x.MaxBackground
+	}
+	return 0
+}
+
+func (x *MountOptions) GetMaxWrite() int32 {
+	if x != nil {
+		return x.MaxWrite
+	}
+	return 0
+}
+
+func (x *MountOptions) GetMaxReadAhead() int32 {
+	if x != nil {
+		return x.MaxReadAhead
+	}
+	return 0
+}
+
+func (x *MountOptions) GetIgnoreSecurityLabels() bool {
+	if x != nil {
+		return x.IgnoreSecurityLabels
+	}
+	return false
+}
+
+func (x *MountOptions) GetRememberInodes() bool {
+	if x != nil {
+		return x.RememberInodes
+	}
+	return false
+}
+
+func (x *MountOptions) GetFsName() string {
+	if x != nil {
+		return x.FsName
+	}
+	return ""
+}
+
+func (x *MountOptions) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type LookupResponse struct {
+func (x *MountOptions) GetSingleThreaded() bool {
+	if x != nil {
+		return x.SingleThreaded
+	}
+	return false
+}
+
+func (x *MountOptions) GetDisableXattrs() bool {
+	if x != nil {
+		return x.DisableXattrs
+	}
+	return false
+}
+
+func (x *MountOptions) GetEnableLocks() bool {
+	if x != nil {
+		return x.EnableLocks
+	}
+	return false
+}
+
+func (x *MountOptions) GetExplicitDataCacheControl() bool {
+	if x != nil {
+		return x.ExplicitDataCacheControl
+	}
+	return false
+}
+
+func (x *MountOptions) GetSyncRead() bool {
+	if x != nil {
+		return x.SyncRead
+	}
+	return false
+}
+
+func (x *MountOptions) GetDirectMount() bool {
+	if x != nil {
+		return x.DirectMount
+	}
+	return false
+}
+
+func (x *MountOptions) GetEnableAcl() bool {
+	if x != nil {
+		return x.EnableAcl
+	}
+	return false
+}
+
+type InitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status   *Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	EntryOut *EntryOut `protobuf:"bytes,2,opt,name=entry_out,json=entryOut,proto3" json:"entry_out,omitempty"`
+	Major uint32 `protobuf:"varint,1,opt,name=major,proto3" json:"major,omitempty"`
+	Minor uint32 `protobuf:"varint,2,opt,name=minor,proto3" json:"minor,omitempty"`
+	// features is the set of Feature bits the client knows.
+	Features uint64 `protobuf:"varint,3,opt,name=features,proto3" json:"features,omitempty"`
+	// kernel and mount_options are sent once the file system is mounted.
+	Kernel       *KernelSettings `protobuf:"bytes,4,opt,name=kernel,proto3" json:"kernel,omitempty"`
+	MountOptions *MountOptions   `protobuf:"bytes,5,opt,name=mount_options,json=mountOptions,proto3" json:"mount_options,omitempty"`
+	// compressors are the gRPC compressors the client can use.
+	Compressors []string `protobuf:"bytes,6,rep,name=compressors,proto3" json:"compressors,omitempty"`
 }
 
-func (x *LookupResponse) Reset() {
-	*x = LookupResponse{}
+func (x *InitRequest) Reset() {
+	*x = InitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[3]
+		mi := &file_raw_file_system_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LookupResponse) String() string {
+func (x *InitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupResponse) ProtoMessage() {}
+func (*InitRequest) ProtoMessage() {}
 
-func (x *LookupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[3]
+func (x *InitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raw_file_system_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,51 +527,641 @@ func (x *LookupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupResponse.ProtoReflect.Descriptor instead.
-func (*LookupResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use InitRequest.ProtoReflect.Descriptor instead.
+func (*InitRequest) Descriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InitRequest) GetMajor() uint32 {
+	if x != nil {
+		return x.Major
+	}
+	return 0
+}
+
+func (x *InitRequest) GetMinor() uint32 {
+	if x != nil {
+		return x.Minor
+	}
+	return 0
+}
+
+func (x *InitRequest) GetFeatures() uint64 {
+	if x != nil {
+		return x.Features
+	}
+	return 0
+}
+
+func (x *InitRequest) GetKernel() *KernelSettings {
+	if x != nil {
+		return x.Kernel
+	}
+	return nil
+}
+
+func (x *InitRequest) GetMountOptions() *MountOptions {
+	if x != nil {
+		return x.MountOptions
+	}
+	return nil
+}
+
+func (x *InitRequest) GetCompressors() []string {
+	if x != nil {
+		return x.Compressors
+	}
+	return nil
+}
+
+type InitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Major uint32 `protobuf:"varint,1,opt,name=major,proto3" json:"major,omitempty"`
+	Minor uint32 `protobuf:"varint,2,opt,name=minor,proto3" json:"minor,omitempty"`
+	// features is the set of Feature bits the server supports.
+	Features uint64 `protobuf:"varint,3,opt,name=features,proto3" json:"features,omitempty"`
+	// compressors are the gRPC compressors the server can decompress and
+	// answer with.
+	Compressors []string `protobuf:"bytes,4,rep,name=compressors,proto3" json:"compressors,omitempty"`
+}
+
+func (x *InitResponse) Reset() {
+	*x = InitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raw_file_system_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitResponse) ProtoMessage() {}
+
+func (x *InitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raw_file_system_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitResponse.ProtoReflect.Descriptor instead.
+func (*InitResponse) Descriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InitResponse) GetMajor() uint32 {
+	if x != nil {
+		return x.Major
+	}
+	return 0
+}
+
+func (x *InitResponse) GetMinor() uint32 {
+	if x != nil {
+		return x.Minor
+	}
+	return 0
+}
+
+func (x *InitResponse) GetFeatures() uint64 {
+	if x != nil {
+		return x.Features
+	}
+	return 0
+}
+
+func (x *InitResponse) GetCompressors() []string {
+	if x != nil {
+		return x.Compressors
+	}
+	return nil
+}
+
+type ListExportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListExportsRequest) Reset() {
+	*x = ListExportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raw_file_system_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportsRequest) ProtoMessage() {}
+
+func (x *ListExportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raw_file_system_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportsRequest.ProtoReflect.Descriptor instead.
+func (*ListExportsRequest) Descriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{6}
+}
+
+type ListExportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exports []string `protobuf:"bytes,1,rep,name=exports,proto3" json:"exports,omitempty"`
+}
+
+func (x *ListExportsResponse) Reset() {
+	*x = ListExportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raw_file_system_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportsResponse) ProtoMessage() {}
+
+func (x *ListExportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raw_file_system_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportsResponse.ProtoReflect.Descriptor instead.
+func (*ListExportsResponse) Descriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListExportsResponse) GetExports() []string {
+	if x != nil {
+		return x.Exports
+	}
+	return nil
+}
+
+type NotifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NotifyRequest) Reset() {
+	*x = NotifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raw_file_system_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyRequest) ProtoMessage() {}
+
+func (x *NotifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raw_file_system_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyRequest.ProtoReflect.Descriptor instead.
+func (*NotifyRequest) Descriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{8}
+}
+
+// InodeNotify invalidates the data cached for the range of node, a
+// negative offset only invalidates its attributes.
+type InodeNotify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node   uint64 `protobuf:"varint,1,opt,name=node,proto3" json:"node,omitempty"`
+	Offset int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length int64  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (x *InodeNotify) Reset() {
+	*x = InodeNotify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raw_file_system_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InodeNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InodeNotify) ProtoMessage() {}
+
+func (x *InodeNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_raw_file_system_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InodeNotify.ProtoReflect.Descriptor instead.
+func (*InodeNotify) Descriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InodeNotify) GetNode() uint64 {
+	if x != nil {
+		return x.Node
+	}
+	return 0
+}
+
+func (x *InodeNotify) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *InodeNotify) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// EntryNotify invalidates the dentry name in the directory parent.
+type EntryNotify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parent uint64 `protobuf:"varint,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *EntryNotify) Reset() {
+	*x = EntryNotify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raw_file_system_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntryNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryNotify) ProtoMessage() {}
+
+func (x *EntryNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_raw_file_system_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryNotify.ProtoReflect.Descriptor instead.
+func (*EntryNotify) Descriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EntryNotify) GetParent() uint64 {
+	if x != nil {
+		return x.Parent
+	}
+	return 0
+}
+
+func (x *EntryNotify) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type NotifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*NotifyResponse_Inode
+	//	*NotifyResponse_Entry
+	Event isNotifyResponse_Event `protobuf_oneof:"event"`
+}
+
+func (x *NotifyResponse) Reset() {
+	*x = NotifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raw_file_system_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyResponse) ProtoMessage() {}
+
+func (x *NotifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raw_file_system_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyResponse.ProtoReflect.Descriptor instead.
+func (*NotifyResponse) Descriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *NotifyResponse) GetEvent() isNotifyResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *NotifyResponse) GetInode() *InodeNotify {
+	if x, ok := x.GetEvent().(*NotifyResponse_Inode); ok {
+		return x.Inode
+	}
+	return nil
+}
+
+func (x *NotifyResponse) GetEntry() *EntryNotify {
+	if x, ok := x.GetEvent().(*NotifyResponse_Entry); ok {
+		return x.Entry
+	}
+	return nil
+}
+
+type isNotifyResponse_Event interface {
+	isNotifyResponse_Event()
+}
+
+type NotifyResponse_Inode struct {
+	Inode *InodeNotify `protobuf:"bytes,1,opt,name=inode,proto3,oneof"`
+}
+
+type NotifyResponse_Entry struct {
+	Entry *EntryNotify `protobuf:"bytes,2,opt,name=entry,proto3,oneof"`
+}
+
+func (*NotifyResponse_Inode) isNotifyResponse_Event() {}
+
+func (*NotifyResponse_Entry) isNotifyResponse_Event() {}
+
+type LookupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *InHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Name   string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *LookupRequest) Reset() {
+	*x = LookupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raw_file_system_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupRequest) ProtoMessage() {}
+
+func (x *LookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raw_file_system_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupRequest.ProtoReflect.Descriptor instead.
+func (*LookupRequest) Descriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LookupRequest) GetHeader() *InHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *LookupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type LookupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   *Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	EntryOut *EntryOut `protobuf:"bytes,2,opt,name=entry_out,json=entryOut,proto3" json:"entry_out,omitempty"`
+}
+
+func (x *LookupResponse) Reset() {
+	*x = LookupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raw_file_system_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupResponse) ProtoMessage() {}
+
+func (x *LookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raw_file_system_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupResponse.ProtoReflect.Descriptor instead.
+func (*LookupResponse) Descriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LookupResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *LookupResponse) GetEntryOut() *EntryOut {
+	if x != nil {
+		return x.EntryOut
+	}
+	return nil
+}
+
+type ForgetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodeid  uint64 `protobuf:"varint,1,opt,name=nodeid,proto3" json:"nodeid,omitempty"`
+	Nlookup uint64 `protobuf:"varint,2,opt,name=nlookup,proto3" json:"nlookup,omitempty"`
+}
+
+func (x *ForgetRequest) Reset() {
+	*x = ForgetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raw_file_system_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetRequest) ProtoMessage() {}
+
+func (x *ForgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raw_file_system_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgetRequest.ProtoReflect.Descriptor instead.
+func (*ForgetRequest) Descriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *LookupResponse) GetStatus() *Status {
+func (x *ForgetRequest) GetNodeid() uint64 {
 	if x != nil {
-		return x.Status
+		return x.Nodeid
 	}
-	return nil
+	return 0
 }
 
-func (x *LookupResponse) GetEntryOut() *EntryOut {
+func (x *ForgetRequest) GetNlookup() uint64 {
 	if x != nil {
-		return x.EntryOut
+		return x.Nlookup
 	}
-	return nil
+	return 0
 }
 
-type ForgetRequest struct {
+type BatchForgetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nodeid  uint64 `protobuf:"varint,1,opt,name=nodeid,proto3" json:"nodeid,omitempty"`
-	Nlookup uint64 `protobuf:"varint,2,opt,name=nlookup,proto3" json:"nlookup,omitempty"`
+	Forgets []*ForgetRequest `protobuf:"bytes,1,rep,name=forgets,proto3" json:"forgets,omitempty"`
 }
 
-func (x *ForgetRequest) Reset() {
-	*x = ForgetRequest{}
+func (x *BatchForgetRequest) Reset() {
+	*x = BatchForgetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[4]
+		mi := &file_raw_file_system_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ForgetRequest) String() string {
+func (x *BatchForgetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForgetRequest) ProtoMessage() {}
+func (*BatchForgetRequest) ProtoMessage() {}
 
-func (x *ForgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[4]
+func (x *BatchForgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raw_file_system_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,23 +1172,16 @@ func (x *ForgetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForgetRequest.ProtoReflect.Descriptor instead.
-func (*ForgetRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *ForgetRequest) GetNodeid() uint64 {
-	if x != nil {
-		return x.Nodeid
-	}
-	return 0
+// Deprecated: Use BatchForgetRequest.ProtoReflect.Descriptor instead.
+func (*BatchForgetRequest) Descriptor() ([]byte, []int) {
+	return file_raw_file_system_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ForgetRequest) GetNlookup() uint64 {
+func (x *BatchForgetRequest) GetForgets() []*ForgetRequest {
 	if x != nil {
-		return x.Nlookup
+		return x.Forgets
 	}
-	return 0
+	return nil
 }
 
 type GetAttrRequest struct {
@@ -297,7 +1195,7 @@ type GetAttrRequest struct {
 func (x *GetAttrRequest) Reset() {
 	*x = GetAttrRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[5]
+		mi := &file_raw_file_system_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -310,7 +1208,7 @@ func (x *GetAttrRequest) String() string {
 func (*GetAttrRequest) ProtoMessage() {}
 
 func (x *GetAttrRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[5]
+	mi := &file_raw_file_system_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -323,7 +1221,7 @@ func (x *GetAttrRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAttrRequest.ProtoReflect.Descriptor instead.
 func (*GetAttrRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{5}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetAttrRequest) GetHeader() *InHeader {
@@ -345,7 +1243,7 @@ type GetAttrResponse struct {
 func (x *GetAttrResponse) Reset() {
 	*x = GetAttrResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[6]
+		mi := &file_raw_file_system_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -358,7 +1256,7 @@ func (x *GetAttrResponse) String() string {
 func (*GetAttrResponse) ProtoMessage() {}
 
 func (x *GetAttrResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[6]
+	mi := &file_raw_file_system_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +1269,7 @@ func (x *GetAttrResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAttrResponse.ProtoReflect.Descriptor instead.
 func (*GetAttrResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{6}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetAttrResponse) GetStatus() *Status {
@@ -414,7 +1312,7 @@ type SetAttrRequest struct {
 func (x *SetAttrRequest) Reset() {
 	*x = SetAttrRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[7]
+		mi := &file_raw_file_system_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -427,7 +1325,7 @@ func (x *SetAttrRequest) String() string {
 func (*SetAttrRequest) ProtoMessage() {}
 
 func (x *SetAttrRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[7]
+	mi := &file_raw_file_system_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,7 +1338,7 @@ func (x *SetAttrRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAttrRequest.ProtoReflect.Descriptor instead.
 func (*SetAttrRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{7}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SetAttrRequest) GetHeader() *InHeader {
@@ -567,7 +1465,7 @@ type SetAttrResponse struct {
 func (x *SetAttrResponse) Reset() {
 	*x = SetAttrResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[8]
+		mi := &file_raw_file_system_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -580,7 +1478,7 @@ func (x *SetAttrResponse) String() string {
 func (*SetAttrResponse) ProtoMessage() {}
 
 func (x *SetAttrResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[8]
+	mi := &file_raw_file_system_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,7 +1491,7 @@ func (x *SetAttrResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAttrResponse.ProtoReflect.Descriptor instead.
 func (*SetAttrResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{8}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SetAttrResponse) GetStatus() *Status {
@@ -626,7 +1524,7 @@ type MknodRequest struct {
 func (x *MknodRequest) Reset() {
 	*x = MknodRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[9]
+		mi := &file_raw_file_system_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -639,7 +1537,7 @@ func (x *MknodRequest) String() string {
 func (*MknodRequest) ProtoMessage() {}
 
 func (x *MknodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[9]
+	mi := &file_raw_file_system_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +1550,7 @@ func (x *MknodRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MknodRequest.ProtoReflect.Descriptor instead.
 func (*MknodRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{9}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MknodRequest) GetHeader() *InHeader {
@@ -702,7 +1600,7 @@ type MknodResponse struct {
 func (x *MknodResponse) Reset() {
 	*x = MknodResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[10]
+		mi := &file_raw_file_system_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -715,7 +1613,7 @@ func (x *MknodResponse) String() string {
 func (*MknodResponse) ProtoMessage() {}
 
 func (x *MknodResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[10]
+	mi := &file_raw_file_system_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +1626,7 @@ func (x *MknodResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MknodResponse.ProtoReflect.Descriptor instead.
 func (*MknodResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{10}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MknodResponse) GetStatus() *Status {
@@ -759,7 +1657,7 @@ type MkdirRequest struct {
 func (x *MkdirRequest) Reset() {
 	*x = MkdirRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[11]
+		mi := &file_raw_file_system_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -772,7 +1670,7 @@ func (x *MkdirRequest) String() string {
 func (*MkdirRequest) ProtoMessage() {}
 
 func (x *MkdirRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[11]
+	mi := &file_raw_file_system_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,7 +1683,7 @@ func (x *MkdirRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MkdirRequest.ProtoReflect.Descriptor instead.
 func (*MkdirRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{11}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *MkdirRequest) GetHeader() *InHeader {
@@ -828,7 +1726,7 @@ type MkdirResponse struct {
 func (x *MkdirResponse) Reset() {
 	*x = MkdirResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[12]
+		mi := &file_raw_file_system_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -841,7 +1739,7 @@ func (x *MkdirResponse) String() string {
 func (*MkdirResponse) ProtoMessage() {}
 
 func (x *MkdirResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[12]
+	mi := &file_raw_file_system_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,7 +1752,7 @@ func (x *MkdirResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MkdirResponse.ProtoReflect.Descriptor instead.
 func (*MkdirResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{12}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *MkdirResponse) GetStatus() *Status {
@@ -883,7 +1781,7 @@ type UnlinkRequest struct {
 func (x *UnlinkRequest) Reset() {
 	*x = UnlinkRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[13]
+		mi := &file_raw_file_system_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -896,7 +1794,7 @@ func (x *UnlinkRequest) String() string {
 func (*UnlinkRequest) ProtoMessage() {}
 
 func (x *UnlinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[13]
+	mi := &file_raw_file_system_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1807,7 @@ func (x *UnlinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlinkRequest.ProtoReflect.Descriptor instead.
 func (*UnlinkRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{13}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UnlinkRequest) GetHeader() *InHeader {
@@ -937,7 +1835,7 @@ type UnlinkResponse struct {
 func (x *UnlinkResponse) Reset() {
 	*x = UnlinkResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[14]
+		mi := &file_raw_file_system_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -950,7 +1848,7 @@ func (x *UnlinkResponse) String() string {
 func (*UnlinkResponse) ProtoMessage() {}
 
 func (x *UnlinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[14]
+	mi := &file_raw_file_system_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,7 +1861,7 @@ func (x *UnlinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlinkResponse.ProtoReflect.Descriptor instead.
 func (*UnlinkResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{14}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *UnlinkResponse) GetStatus() *Status {
@@ -985,7 +1883,7 @@ type RmdirRequest struct {
 func (x *RmdirRequest) Reset() {
 	*x = RmdirRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[15]
+		mi := &file_raw_file_system_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -998,7 +1896,7 @@ func (x *RmdirRequest) String() string {
 func (*RmdirRequest) ProtoMessage() {}
 
 func (x *RmdirRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[15]
+	mi := &file_raw_file_system_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,7 +1909,7 @@ func (x *RmdirRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RmdirRequest.ProtoReflect.Descriptor instead.
 func (*RmdirRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{15}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *RmdirRequest) GetHeader() *InHeader {
@@ -1039,7 +1937,7 @@ type RmdirResponse struct {
 func (x *RmdirResponse) Reset() {
 	*x = RmdirResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[16]
+		mi := &file_raw_file_system_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1052,7 +1950,7 @@ func (x *RmdirResponse) String() string {
 func (*RmdirResponse) ProtoMessage() {}
 
 func (x *RmdirResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[16]
+	mi := &file_raw_file_system_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1065,7 +1963,7 @@ func (x *RmdirResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RmdirResponse.ProtoReflect.Descriptor instead.
 func (*RmdirResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{16}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *RmdirResponse) GetStatus() *Status {
@@ -1091,7 +1989,7 @@ type RenameRequest struct {
 func (x *RenameRequest) Reset() {
 	*x = RenameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[17]
+		mi := &file_raw_file_system_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1104,7 +2002,7 @@ func (x *RenameRequest) String() string {
 func (*RenameRequest) ProtoMessage() {}
 
 func (x *RenameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[17]
+	mi := &file_raw_file_system_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1117,7 +2015,7 @@ func (x *RenameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameRequest.ProtoReflect.Descriptor instead.
 func (*RenameRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{17}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *RenameRequest) GetHeader() *InHeader {
@@ -1173,7 +2071,7 @@ type RenameResponse struct {
 func (x *RenameResponse) Reset() {
 	*x = RenameResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[18]
+		mi := &file_raw_file_system_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1186,7 +2084,7 @@ func (x *RenameResponse) String() string {
 func (*RenameResponse) ProtoMessage() {}
 
 func (x *RenameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[18]
+	mi := &file_raw_file_system_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,7 +2097,7 @@ func (x *RenameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameResponse.ProtoReflect.Descriptor instead.
 func (*RenameResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{18}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *RenameResponse) GetStatus() *Status {
@@ -1222,7 +2120,7 @@ type LinkRequest struct {
 func (x *LinkRequest) Reset() {
 	*x = LinkRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[19]
+		mi := &file_raw_file_system_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1235,7 +2133,7 @@ func (x *LinkRequest) String() string {
 func (*LinkRequest) ProtoMessage() {}
 
 func (x *LinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[19]
+	mi := &file_raw_file_system_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,7 +2146,7 @@ func (x *LinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkRequest.ProtoReflect.Descriptor instead.
 func (*LinkRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{19}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *LinkRequest) GetHeader() *InHeader {
@@ -1284,7 +2182,7 @@ type LinkResponse struct {
 func (x *LinkResponse) Reset() {
 	*x = LinkResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[20]
+		mi := &file_raw_file_system_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1297,7 +2195,7 @@ func (x *LinkResponse) String() string {
 func (*LinkResponse) ProtoMessage() {}
 
 func (x *LinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[20]
+	mi := &file_raw_file_system_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1310,7 +2208,7 @@ func (x *LinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkResponse.ProtoReflect.Descriptor instead.
 func (*LinkResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{20}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *LinkResponse) GetStatus() *Status {
@@ -1340,7 +2238,7 @@ type SymlinkRequest struct {
 func (x *SymlinkRequest) Reset() {
 	*x = SymlinkRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[21]
+		mi := &file_raw_file_system_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1353,7 +2251,7 @@ func (x *SymlinkRequest) String() string {
 func (*SymlinkRequest) ProtoMessage() {}
 
 func (x *SymlinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[21]
+	mi := &file_raw_file_system_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1366,7 +2264,7 @@ func (x *SymlinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SymlinkRequest.ProtoReflect.Descriptor instead.
 func (*SymlinkRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{21}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SymlinkRequest) GetHeader() *InHeader {
@@ -1402,7 +2300,7 @@ type SymlinkResponse struct {
 func (x *SymlinkResponse) Reset() {
 	*x = SymlinkResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[22]
+		mi := &file_raw_file_system_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1415,7 +2313,7 @@ func (x *SymlinkResponse) String() string {
 func (*SymlinkResponse) ProtoMessage() {}
 
 func (x *SymlinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[22]
+	mi := &file_raw_file_system_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1428,7 +2326,7 @@ func (x *SymlinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SymlinkResponse.ProtoReflect.Descriptor instead.
 func (*SymlinkResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{22}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SymlinkResponse) GetStatus() *Status {
@@ -1456,7 +2354,7 @@ type ReadlinkRequest struct {
 func (x *ReadlinkRequest) Reset() {
 	*x = ReadlinkRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[23]
+		mi := &file_raw_file_system_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1469,7 +2367,7 @@ func (x *ReadlinkRequest) String() string {
 func (*ReadlinkRequest) ProtoMessage() {}
 
 func (x *ReadlinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[23]
+	mi := &file_raw_file_system_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1482,7 +2380,7 @@ func (x *ReadlinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadlinkRequest.ProtoReflect.Descriptor instead.
 func (*ReadlinkRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{23}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ReadlinkRequest) GetHeader() *InHeader {
@@ -1504,7 +2402,7 @@ type ReadlinkResponse struct {
 func (x *ReadlinkResponse) Reset() {
 	*x = ReadlinkResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[24]
+		mi := &file_raw_file_system_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1517,7 +2415,7 @@ func (x *ReadlinkResponse) String() string {
 func (*ReadlinkResponse) ProtoMessage() {}
 
 func (x *ReadlinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[24]
+	mi := &file_raw_file_system_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1530,7 +2428,7 @@ func (x *ReadlinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadlinkResponse.ProtoReflect.Descriptor instead.
 func (*ReadlinkResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{24}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ReadlinkResponse) GetStatus() *Status {
@@ -1560,7 +2458,7 @@ type AccessRequest struct {
 func (x *AccessRequest) Reset() {
 	*x = AccessRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[25]
+		mi := &file_raw_file_system_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1573,7 +2471,7 @@ func (x *AccessRequest) String() string {
 func (*AccessRequest) ProtoMessage() {}
 
 func (x *AccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[25]
+	mi := &file_raw_file_system_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1586,7 +2484,7 @@ func (x *AccessRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessRequest.ProtoReflect.Descriptor instead.
 func (*AccessRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{25}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *AccessRequest) GetHeader() *InHeader {
@@ -1621,7 +2519,7 @@ type AccessResponse struct {
 func (x *AccessResponse) Reset() {
 	*x = AccessResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[26]
+		mi := &file_raw_file_system_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1634,7 +2532,7 @@ func (x *AccessResponse) String() string {
 func (*AccessResponse) ProtoMessage() {}
 
 func (x *AccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[26]
+	mi := &file_raw_file_system_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1647,7 +2545,7 @@ func (x *AccessResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessResponse.ProtoReflect.Descriptor instead.
 func (*AccessResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{26}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *AccessResponse) GetStatus() *Status {
@@ -1670,7 +2568,7 @@ type GetXAttrRequest struct {
 func (x *GetXAttrRequest) Reset() {
 	*x = GetXAttrRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[27]
+		mi := &file_raw_file_system_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1683,7 +2581,7 @@ func (x *GetXAttrRequest) String() string {
 func (*GetXAttrRequest) ProtoMessage() {}
 
 func (x *GetXAttrRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[27]
+	mi := &file_raw_file_system_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1696,7 +2594,7 @@ func (x *GetXAttrRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetXAttrRequest.ProtoReflect.Descriptor instead.
 func (*GetXAttrRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{27}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetXAttrRequest) GetHeader() *InHeader {
@@ -1732,7 +2630,7 @@ type GetXAttrResponse struct {
 func (x *GetXAttrResponse) Reset() {
 	*x = GetXAttrResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[28]
+		mi := &file_raw_file_system_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1745,7 +2643,7 @@ func (x *GetXAttrResponse) String() string {
 func (*GetXAttrResponse) ProtoMessage() {}
 
 func (x *GetXAttrResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[28]
+	mi := &file_raw_file_system_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1758,7 +2656,7 @@ func (x *GetXAttrResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetXAttrResponse.ProtoReflect.Descriptor instead.
 func (*GetXAttrResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{28}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetXAttrResponse) GetStatus() *Status {
@@ -1787,7 +2685,7 @@ type ListXAttrRequest struct {
 func (x *ListXAttrRequest) Reset() {
 	*x = ListXAttrRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[29]
+		mi := &file_raw_file_system_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1800,7 +2698,7 @@ func (x *ListXAttrRequest) String() string {
 func (*ListXAttrRequest) ProtoMessage() {}
 
 func (x *ListXAttrRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[29]
+	mi := &file_raw_file_system_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1813,7 +2711,7 @@ func (x *ListXAttrRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListXAttrRequest.ProtoReflect.Descriptor instead.
 func (*ListXAttrRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{29}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ListXAttrRequest) GetHeader() *InHeader {
@@ -1842,7 +2740,7 @@ type ListXAttrResponse struct {
 func (x *ListXAttrResponse) Reset() {
 	*x = ListXAttrResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[30]
+		mi := &file_raw_file_system_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1855,7 +2753,7 @@ func (x *ListXAttrResponse) String() string {
 func (*ListXAttrResponse) ProtoMessage() {}
 
 func (x *ListXAttrResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[30]
+	mi := &file_raw_file_system_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1868,7 +2766,7 @@ func (x *ListXAttrResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListXAttrResponse.ProtoReflect.Descriptor instead.
 func (*ListXAttrResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{30}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ListXAttrResponse) GetStatus() *Status {
@@ -1902,7 +2800,7 @@ type SetXAttrRequest struct {
 func (x *SetXAttrRequest) Reset() {
 	*x = SetXAttrRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[31]
+		mi := &file_raw_file_system_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1915,7 +2813,7 @@ func (x *SetXAttrRequest) String() string {
 func (*SetXAttrRequest) ProtoMessage() {}
 
 func (x *SetXAttrRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[31]
+	mi := &file_raw_file_system_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1928,7 +2826,7 @@ func (x *SetXAttrRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetXAttrRequest.ProtoReflect.Descriptor instead.
 func (*SetXAttrRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{31}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *SetXAttrRequest) GetHeader() *InHeader {
@@ -1991,7 +2889,7 @@ type SetXAttrResponse struct {
 func (x *SetXAttrResponse) Reset() {
 	*x = SetXAttrResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[32]
+		mi := &file_raw_file_system_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2004,7 +2902,7 @@ func (x *SetXAttrResponse) String() string {
 func (*SetXAttrResponse) ProtoMessage() {}
 
 func (x *SetXAttrResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[32]
+	mi := &file_raw_file_system_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2017,7 +2915,7 @@ func (x *SetXAttrResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetXAttrResponse.ProtoReflect.Descriptor instead.
 func (*SetXAttrResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{32}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *SetXAttrResponse) GetStatus() *Status {
@@ -2039,7 +2937,7 @@ type RemoveXAttrRequest struct {
 func (x *RemoveXAttrRequest) Reset() {
 	*x = RemoveXAttrRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[33]
+		mi := &file_raw_file_system_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2052,7 +2950,7 @@ func (x *RemoveXAttrRequest) String() string {
 func (*RemoveXAttrRequest) ProtoMessage() {}
 
 func (x *RemoveXAttrRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[33]
+	mi := &file_raw_file_system_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2065,7 +2963,7 @@ func (x *RemoveXAttrRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveXAttrRequest.ProtoReflect.Descriptor instead.
 func (*RemoveXAttrRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{33}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *RemoveXAttrRequest) GetHeader() *InHeader {
@@ -2093,7 +2991,7 @@ type RemoveXAttrResponse struct {
 func (x *RemoveXAttrResponse) Reset() {
 	*x = RemoveXAttrResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[34]
+		mi := &file_raw_file_system_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2106,7 +3004,7 @@ func (x *RemoveXAttrResponse) String() string {
 func (*RemoveXAttrResponse) ProtoMessage() {}
 
 func (x *RemoveXAttrResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[34]
+	mi := &file_raw_file_system_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2119,7 +3017,7 @@ func (x *RemoveXAttrResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveXAttrResponse.ProtoReflect.Descriptor instead.
 func (*RemoveXAttrResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{34}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *RemoveXAttrResponse) GetStatus() *Status {
@@ -2146,7 +3044,7 @@ type CreateRequest struct {
 func (x *CreateRequest) Reset() {
 	*x = CreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[35]
+		mi := &file_raw_file_system_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2159,7 +3057,7 @@ func (x *CreateRequest) String() string {
 func (*CreateRequest) ProtoMessage() {}
 
 func (x *CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[35]
+	mi := &file_raw_file_system_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,7 +3070,7 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{35}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *CreateRequest) GetHeader() *InHeader {
@@ -2230,7 +3128,7 @@ type CreateResponse struct {
 func (x *CreateResponse) Reset() {
 	*x = CreateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[36]
+		mi := &file_raw_file_system_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2243,7 +3141,7 @@ func (x *CreateResponse) String() string {
 func (*CreateResponse) ProtoMessage() {}
 
 func (x *CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[36]
+	mi := &file_raw_file_system_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2256,7 +3154,7 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{36}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *CreateResponse) GetStatus() *Status {
@@ -2291,7 +3189,7 @@ type OpenRequest struct {
 func (x *OpenRequest) Reset() {
 	*x = OpenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[37]
+		mi := &file_raw_file_system_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2304,7 +3202,7 @@ func (x *OpenRequest) String() string {
 func (*OpenRequest) ProtoMessage() {}
 
 func (x *OpenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[37]
+	mi := &file_raw_file_system_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2317,7 +3215,7 @@ func (x *OpenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenRequest.ProtoReflect.Descriptor instead.
 func (*OpenRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{37}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *OpenRequest) GetOpenIn() *OpenIn {
@@ -2339,7 +3237,7 @@ type OpenResponse struct {
 func (x *OpenResponse) Reset() {
 	*x = OpenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[38]
+		mi := &file_raw_file_system_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2352,7 +3250,7 @@ func (x *OpenResponse) String() string {
 func (*OpenResponse) ProtoMessage() {}
 
 func (x *OpenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[38]
+	mi := &file_raw_file_system_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2365,7 +3263,7 @@ func (x *OpenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenResponse.ProtoReflect.Descriptor instead.
 func (*OpenResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{38}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *OpenResponse) GetStatus() *Status {
@@ -2388,12 +3286,14 @@ type ReadRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	ReadIn *ReadIn `protobuf:"bytes,1,opt,name=read_in,json=readIn,proto3" json:"read_in,omitempty"`
+	// checksum_type asks the server for checksums of the buffers.
+	ChecksumType ChecksumType `protobuf:"varint,2,opt,name=checksum_type,json=checksumType,proto3,enum=pb.ChecksumType" json:"checksum_type,omitempty"`
 }
 
 func (x *ReadRequest) Reset() {
 	*x = ReadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[39]
+		mi := &file_raw_file_system_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2406,7 +3306,7 @@ func (x *ReadRequest) String() string {
 func (*ReadRequest) ProtoMessage() {}
 
 func (x *ReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[39]
+	mi := &file_raw_file_system_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2419,7 +3319,7 @@ func (x *ReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
 func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{39}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ReadRequest) GetReadIn() *ReadIn {
@@ -2429,19 +3329,27 @@ func (x *ReadRequest) GetReadIn() *ReadIn {
 	return nil
 }
 
+func (x *ReadRequest) GetChecksumType() ChecksumType {
+	if x != nil {
+		return x.ChecksumType
+	}
+	return ChecksumType_CHECKSUM_NONE
+}
+
 type ReadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Buffer []byte  `protobuf:"bytes,2,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	Status   *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Buffer   []byte  `protobuf:"bytes,2,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	Checksum uint64  `protobuf:"varint,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
 }
 
 func (x *ReadResponse) Reset() {
 	*x = ReadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[40]
+		mi := &file_raw_file_system_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2454,7 +3362,7 @@ func (x *ReadResponse) String() string {
 func (*ReadResponse) ProtoMessage() {}
 
 func (x *ReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[40]
+	mi := &file_raw_file_system_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2467,7 +3375,7 @@ func (x *ReadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadResponse.ProtoReflect.Descriptor instead.
 func (*ReadResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{40}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ReadResponse) GetStatus() *Status {
@@ -2484,6 +3392,13 @@ func (x *ReadResponse) GetBuffer() []byte {
 	return nil
 }
 
+func (x *ReadResponse) GetChecksum() uint64 {
+	if x != nil {
+		return x.Checksum
+	}
+	return 0
+}
+
 type LseekRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2499,7 +3414,7 @@ type LseekRequest struct {
 func (x *LseekRequest) Reset() {
 	*x = LseekRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[41]
+		mi := &file_raw_file_system_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2512,7 +3427,7 @@ func (x *LseekRequest) String() string {
 func (*LseekRequest) ProtoMessage() {}
 
 func (x *LseekRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[41]
+	mi := &file_raw_file_system_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2525,7 +3440,7 @@ func (x *LseekRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LseekRequest.ProtoReflect.Descriptor instead.
 func (*LseekRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{41}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *LseekRequest) GetHeader() *InHeader {
@@ -2575,7 +3490,7 @@ type LseekResponse struct {
 func (x *LseekResponse) Reset() {
 	*x = LseekResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[42]
+		mi := &file_raw_file_system_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2588,7 +3503,7 @@ func (x *LseekResponse) String() string {
 func (*LseekResponse) ProtoMessage() {}
 
 func (x *LseekResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[42]
+	mi := &file_raw_file_system_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2601,7 +3516,7 @@ func (x *LseekResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LseekResponse.ProtoReflect.Descriptor instead.
 func (*LseekResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{42}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *LseekResponse) GetStatus() *Status {
@@ -2634,7 +3549,7 @@ type LkRequest struct {
 func (x *LkRequest) Reset() {
 	*x = LkRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[43]
+		mi := &file_raw_file_system_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2647,7 +3562,7 @@ func (x *LkRequest) String() string {
 func (*LkRequest) ProtoMessage() {}
 
 func (x *LkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[43]
+	mi := &file_raw_file_system_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2660,7 +3575,7 @@ func (x *LkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LkRequest.ProtoReflect.Descriptor instead.
 func (*LkRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{43}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *LkRequest) GetHeader() *InHeader {
@@ -2717,7 +3632,7 @@ type GetLkResponse struct {
 func (x *GetLkResponse) Reset() {
 	*x = GetLkResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[44]
+		mi := &file_raw_file_system_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2730,7 +3645,7 @@ func (x *GetLkResponse) String() string {
 func (*GetLkResponse) ProtoMessage() {}
 
 func (x *GetLkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[44]
+	mi := &file_raw_file_system_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2743,7 +3658,7 @@ func (x *GetLkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLkResponse.ProtoReflect.Descriptor instead.
 func (*GetLkResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{44}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetLkResponse) GetStatus() *Status {
@@ -2771,7 +3686,7 @@ type SetLkResponse struct {
 func (x *SetLkResponse) Reset() {
 	*x = SetLkResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[45]
+		mi := &file_raw_file_system_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2784,7 +3699,7 @@ func (x *SetLkResponse) String() string {
 func (*SetLkResponse) ProtoMessage() {}
 
 func (x *SetLkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[45]
+	mi := &file_raw_file_system_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2797,7 +3712,7 @@ func (x *SetLkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLkResponse.ProtoReflect.Descriptor instead.
 func (*SetLkResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{45}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *SetLkResponse) GetStatus() *Status {
@@ -2822,7 +3737,7 @@ type ReleaseRequest struct {
 func (x *ReleaseRequest) Reset() {
 	*x = ReleaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[46]
+		mi := &file_raw_file_system_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2835,7 +3750,7 @@ func (x *ReleaseRequest) String() string {
 func (*ReleaseRequest) ProtoMessage() {}
 
 func (x *ReleaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[46]
+	mi := &file_raw_file_system_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2848,7 +3763,7 @@ func (x *ReleaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseRequest.ProtoReflect.Descriptor instead.
 func (*ReleaseRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{46}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ReleaseRequest) GetHeader() *InHeader {
@@ -2900,12 +3815,16 @@ type WriteRequest struct {
 	LockOwner  uint64    `protobuf:"varint,7,opt,name=lock_owner,json=lockOwner,proto3" json:"lock_owner,omitempty"`
 	Flags      uint32    `protobuf:"varint,8,opt,name=flags,proto3" json:"flags,omitempty"`
 	Padding    uint32    `protobuf:"varint,9,opt,name=padding,proto3" json:"padding,omitempty"`
+	// checksum is the checksum of data, every message of a WriteStream
+	// carries the one of its own data.
+	ChecksumType ChecksumType `protobuf:"varint,10,opt,name=checksum_type,json=checksumType,proto3,enum=pb.ChecksumType" json:"checksum_type,omitempty"`
+	Checksum     uint64       `protobuf:"varint,11,opt,name=checksum,proto3" json:"checksum,omitempty"`
 }
 
 func (x *WriteRequest) Reset() {
 	*x = WriteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[47]
+		mi := &file_raw_file_system_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2918,7 +3837,7 @@ func (x *WriteRequest) String() string {
 func (*WriteRequest) ProtoMessage() {}
 
 func (x *WriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[47]
+	mi := &file_raw_file_system_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2931,7 +3850,7 @@ func (x *WriteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteRequest.ProtoReflect.Descriptor instead.
 func (*WriteRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{47}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *WriteRequest) GetHeader() *InHeader {
@@ -2997,6 +3916,20 @@ func (x *WriteRequest) GetPadding() uint32 {
 	return 0
 }
 
+func (x *WriteRequest) GetChecksumType() ChecksumType {
+	if x != nil {
+		return x.ChecksumType
+	}
+	return ChecksumType_CHECKSUM_NONE
+}
+
+func (x *WriteRequest) GetChecksum() uint64 {
+	if x != nil {
+		return x.Checksum
+	}
+	return 0
+}
+
 type WriteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3009,7 +3942,7 @@ type WriteResponse struct {
 func (x *WriteResponse) Reset() {
 	*x = WriteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[48]
+		mi := &file_raw_file_system_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3022,7 +3955,7 @@ func (x *WriteResponse) String() string {
 func (*WriteResponse) ProtoMessage() {}
 
 func (x *WriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[48]
+	mi := &file_raw_file_system_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3035,7 +3968,7 @@ func (x *WriteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteResponse.ProtoReflect.Descriptor instead.
 func (*WriteResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{48}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *WriteResponse) GetStatus() *Status {
@@ -3070,7 +4003,7 @@ type CopyFileRangeRequest struct {
 func (x *CopyFileRangeRequest) Reset() {
 	*x = CopyFileRangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[49]
+		mi := &file_raw_file_system_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3083,7 +4016,7 @@ func (x *CopyFileRangeRequest) String() string {
 func (*CopyFileRangeRequest) ProtoMessage() {}
 
 func (x *CopyFileRangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[49]
+	mi := &file_raw_file_system_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3096,7 +4029,7 @@ func (x *CopyFileRangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CopyFileRangeRequest.ProtoReflect.Descriptor instead.
 func (*CopyFileRangeRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{49}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *CopyFileRangeRequest) GetHeader() *InHeader {
@@ -3167,7 +4100,7 @@ type CopyFileRangeResponse struct {
 func (x *CopyFileRangeResponse) Reset() {
 	*x = CopyFileRangeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[50]
+		mi := &file_raw_file_system_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3180,7 +4113,7 @@ func (x *CopyFileRangeResponse) String() string {
 func (*CopyFileRangeResponse) ProtoMessage() {}
 
 func (x *CopyFileRangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[50]
+	mi := &file_raw_file_system_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3193,7 +4126,7 @@ func (x *CopyFileRangeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CopyFileRangeResponse.ProtoReflect.Descriptor instead.
 func (*CopyFileRangeResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{50}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *CopyFileRangeResponse) GetStatus() *Status {
@@ -3225,7 +4158,7 @@ type FlushRequest struct {
 func (x *FlushRequest) Reset() {
 	*x = FlushRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[51]
+		mi := &file_raw_file_system_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3238,7 +4171,7 @@ func (x *FlushRequest) String() string {
 func (*FlushRequest) ProtoMessage() {}
 
 func (x *FlushRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[51]
+	mi := &file_raw_file_system_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3251,7 +4184,7 @@ func (x *FlushRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlushRequest.ProtoReflect.Descriptor instead.
 func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{51}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *FlushRequest) GetHeader() *InHeader {
@@ -3300,7 +4233,7 @@ type FlushResponse struct {
 func (x *FlushResponse) Reset() {
 	*x = FlushResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[52]
+		mi := &file_raw_file_system_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3313,7 +4246,7 @@ func (x *FlushResponse) String() string {
 func (*FlushResponse) ProtoMessage() {}
 
 func (x *FlushResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[52]
+	mi := &file_raw_file_system_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3326,7 +4259,7 @@ func (x *FlushResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlushResponse.ProtoReflect.Descriptor instead.
 func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{52}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *FlushResponse) GetStatus() *Status {
@@ -3350,7 +4283,7 @@ type FsyncRequest struct {
 func (x *FsyncRequest) Reset() {
 	*x = FsyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[53]
+		mi := &file_raw_file_system_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3363,7 +4296,7 @@ func (x *FsyncRequest) String() string {
 func (*FsyncRequest) ProtoMessage() {}
 
 func (x *FsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[53]
+	mi := &file_raw_file_system_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3376,7 +4309,7 @@ func (x *FsyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FsyncRequest.ProtoReflect.Descriptor instead.
 func (*FsyncRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{53}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *FsyncRequest) GetHeader() *InHeader {
@@ -3418,7 +4351,7 @@ type FsyncResponse struct {
 func (x *FsyncResponse) Reset() {
 	*x = FsyncResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[54]
+		mi := &file_raw_file_system_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3431,7 +4364,7 @@ func (x *FsyncResponse) String() string {
 func (*FsyncResponse) ProtoMessage() {}
 
 func (x *FsyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[54]
+	mi := &file_raw_file_system_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3444,7 +4377,7 @@ func (x *FsyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FsyncResponse.ProtoReflect.Descriptor instead.
 func (*FsyncResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{54}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *FsyncResponse) GetStatus() *Status {
@@ -3470,7 +4403,7 @@ type FallocateRequest struct {
 func (x *FallocateRequest) Reset() {
 	*x = FallocateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[55]
+		mi := &file_raw_file_system_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3483,7 +4416,7 @@ func (x *FallocateRequest) String() string {
 func (*FallocateRequest) ProtoMessage() {}
 
 func (x *FallocateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[55]
+	mi := &file_raw_file_system_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3496,7 +4429,7 @@ func (x *FallocateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FallocateRequest.ProtoReflect.Descriptor instead.
 func (*FallocateRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{55}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *FallocateRequest) GetHeader() *InHeader {
@@ -3552,7 +4485,7 @@ type FallocateResponse struct {
 func (x *FallocateResponse) Reset() {
 	*x = FallocateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[56]
+		mi := &file_raw_file_system_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3565,7 +4498,7 @@ func (x *FallocateResponse) String() string {
 func (*FallocateResponse) ProtoMessage() {}
 
 func (x *FallocateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[56]
+	mi := &file_raw_file_system_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3578,7 +4511,7 @@ func (x *FallocateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FallocateResponse.ProtoReflect.Descriptor instead.
 func (*FallocateResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{56}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *FallocateResponse) GetStatus() *Status {
@@ -3599,7 +4532,7 @@ type OpenDirRequest struct {
 func (x *OpenDirRequest) Reset() {
 	*x = OpenDirRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[57]
+		mi := &file_raw_file_system_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3612,7 +4545,7 @@ func (x *OpenDirRequest) String() string {
 func (*OpenDirRequest) ProtoMessage() {}
 
 func (x *OpenDirRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[57]
+	mi := &file_raw_file_system_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3625,7 +4558,7 @@ func (x *OpenDirRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenDirRequest.ProtoReflect.Descriptor instead.
 func (*OpenDirRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{57}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *OpenDirRequest) GetOpenIn() *OpenIn {
@@ -3647,7 +4580,7 @@ type OpenDirResponse struct {
 func (x *OpenDirResponse) Reset() {
 	*x = OpenDirResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[58]
+		mi := &file_raw_file_system_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3660,7 +4593,7 @@ func (x *OpenDirResponse) String() string {
 func (*OpenDirResponse) ProtoMessage() {}
 
 func (x *OpenDirResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[58]
+	mi := &file_raw_file_system_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3673,7 +4606,7 @@ func (x *OpenDirResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenDirResponse.ProtoReflect.Descriptor instead.
 func (*OpenDirResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{58}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *OpenDirResponse) GetStatus() *Status {
@@ -3701,7 +4634,7 @@ type ReadDirRequest struct {
 func (x *ReadDirRequest) Reset() {
 	*x = ReadDirRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[59]
+		mi := &file_raw_file_system_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3714,7 +4647,7 @@ func (x *ReadDirRequest) String() string {
 func (*ReadDirRequest) ProtoMessage() {}
 
 func (x *ReadDirRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[59]
+	mi := &file_raw_file_system_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3727,7 +4660,7 @@ func (x *ReadDirRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadDirRequest.ProtoReflect.Descriptor instead.
 func (*ReadDirRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{59}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ReadDirRequest) GetReadIn() *ReadIn {
@@ -3749,7 +4682,7 @@ type ReadDirResponse struct {
 func (x *ReadDirResponse) Reset() {
 	*x = ReadDirResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[60]
+		mi := &file_raw_file_system_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3762,7 +4695,7 @@ func (x *ReadDirResponse) String() string {
 func (*ReadDirResponse) ProtoMessage() {}
 
 func (x *ReadDirResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[60]
+	mi := &file_raw_file_system_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3775,7 +4708,7 @@ func (x *ReadDirResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadDirResponse.ProtoReflect.Descriptor instead.
 func (*ReadDirResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{60}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *ReadDirResponse) GetStatus() *Status {
@@ -3803,7 +4736,7 @@ type StatfsRequest struct {
 func (x *StatfsRequest) Reset() {
 	*x = StatfsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[61]
+		mi := &file_raw_file_system_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3816,7 +4749,7 @@ func (x *StatfsRequest) String() string {
 func (*StatfsRequest) ProtoMessage() {}
 
 func (x *StatfsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[61]
+	mi := &file_raw_file_system_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3829,7 +4762,7 @@ func (x *StatfsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatfsRequest.ProtoReflect.Descriptor instead.
 func (*StatfsRequest) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{61}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *StatfsRequest) GetInput() *InHeader {
@@ -3860,7 +4793,7 @@ type StatfsResponse struct {
 func (x *StatfsResponse) Reset() {
 	*x = StatfsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_raw_file_system_proto_msgTypes[62]
+		mi := &file_raw_file_system_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3873,7 +4806,7 @@ func (x *StatfsResponse) String() string {
 func (*StatfsResponse) ProtoMessage() {}
 
 func (x *StatfsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_raw_file_system_proto_msgTypes[62]
+	mi := &file_raw_file_system_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3886,7 +4819,7 @@ func (x *StatfsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatfsResponse.ProtoReflect.Descriptor instead.
 func (*StatfsResponse) Descriptor() ([]byte, []int) {
-	return file_raw_file_system_proto_rawDescGZIP(), []int{62}
+	return file_raw_file_system_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *StatfsResponse) GetStatus() *Status {
@@ -3977,535 +4910,672 @@ var file_raw_file_system_proto_rawDesc = []byte{
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x26, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x69, 0x6e,
 	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x49, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x0e, 0x4c, 0x6f,
-	0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x29, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75,
-	0x74, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x41, 0x0a, 0x0d, 0x46,
-	0x6f, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x6e, 0x6f, 0x64, 0x65, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x6f,
-	0x64, 0x65, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6e, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x22, 0x36,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a,
-	0x08, 0x61, 0x74, 0x74, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x61, 0x74,
-	0x74, 0x72, 0x4f, 0x75, 0x74, 0x22, 0xae, 0x03, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0e,
-	0x0a, 0x02, 0x66, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x66, 0x68, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69,
-	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x61, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x74,
-	0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x65, 0x63,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x65,
-	0x63, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x65, 0x63, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x65, 0x63, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x65, 0x63, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x09, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x65, 0x63, 0x12, 0x12, 0x0a,
-	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x34, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x34, 0x12, 0x1f, 0x0a, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x35, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75,
-	0x6e, 0x75, 0x73, 0x65, 0x64, 0x35, 0x22, 0x5d, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a,
-	0x08, 0x61, 0x74, 0x74, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x61, 0x74,
-	0x74, 0x72, 0x4f, 0x75, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x0c, 0x4d, 0x6b, 0x6e, 0x6f, 0x64, 0x52,
+	0x77, 0x0a, 0x0e, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x61, 0x64, 0x61, 0x68, 0x65,
+	0x61, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0xd0, 0x04, 0x0a, 0x0c, 0x4d, 0x6f, 0x75,
+	0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x5f, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61,
+	0x78, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x61, 0x64, 0x5f, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x61, 0x64, 0x41, 0x68, 0x65, 0x61, 0x64, 0x12, 0x34,
+	0x0a, 0x16, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72,
+	0x65, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x66, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x78,
+	0x61, 0x74, 0x74, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x58, 0x61, 0x74, 0x74, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x3d, 0x0a,
+	0x1b, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x18, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x6c, 0x22, 0xda, 0x01, 0x0a, 0x0b,
+	0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x61, 0x6a, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x61, 0x6a, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x06, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x12,
+	0x35, 0x0a, 0x0d, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x75, 0x6e,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x22, 0x78, 0x0a, 0x0c, 0x49, 0x6e, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d,
+	0x69, 0x6e, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x0b, 0x49, 0x6e,
+	0x6f, 0x64, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x39, 0x0a,
+	0x0b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6b, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x49,
+	0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x07, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65,
 	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
-	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x64, 0x65, 0x76, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x72, 0x64, 0x65, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6d, 0x61, 0x73,
-	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x75, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0x5e,
-	0x0a, 0x0d, 0x4d, 0x6b, 0x6e, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x75, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x4f, 0x75, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x72,
-	0x0a, 0x0c, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
-	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x75, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x75, 0x6d, 0x61,
-	0x73, 0x6b, 0x22, 0x5e, 0x0a, 0x0d, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79,
-	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x4f,
-	0x75, 0x74, 0x22, 0x49, 0x0a, 0x0d, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a,
-	0x0e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x48, 0x0a, 0x0c, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a,
-	0x0d, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c,
-	0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x6c,
-	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x77, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x6e, 0x65, 0x77, 0x64, 0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x34, 0x0a, 0x0e, 0x52, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6d,
-	0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x22, 0x5f, 0x0a, 0x0e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75,
+	0x74, 0x22, 0x41, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6e, 0x6c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x22, 0x41, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6f, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x66, 0x6f,
+	0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x66, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x74,
+	0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49,
+	0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22,
+	0x5d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x72, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x61, 0x74, 0x74, 0x72, 0x4f, 0x75, 0x74, 0x22, 0xae,
+	0x03, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x66, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x65, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x61, 0x74, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x65, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x74,
+	0x69, 0x6d, 0x65, 0x6e, 0x73, 0x65, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d,
+	0x74, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x65, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x74, 0x69, 0x6d,
+	0x65, 0x6e, 0x73, 0x65, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x74, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e,
+	0x75, 0x73, 0x65, 0x64, 0x34, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x6e, 0x75,
+	0x73, 0x65, 0x64, 0x34, 0x12, 0x1f, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x35,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x35, 0x22,
+	0x5d, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x72, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x61, 0x74, 0x74, 0x72, 0x4f, 0x75, 0x74, 0x22, 0x86,
+	0x01, 0x0a, 0x0c, 0x4d, 0x6b, 0x6e, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x64, 0x65, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x64, 0x65,
+	0x76, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x75, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0x5e, 0x0a, 0x0d, 0x4d, 0x6b, 0x6e, 0x6f, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x52, 0x08, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x72, 0x0a, 0x0c, 0x4d, 0x6b, 0x64, 0x69, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x75, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0x5e, 0x0a, 0x0d, 0x4d,
+	0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x29, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75,
+	0x74, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x49, 0x0a, 0x0d, 0x55,
+	0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x0e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x48, 0x0a, 0x0c,
+	0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x0d, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x0d,
+	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
 	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
 	0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x6e, 0x6f, 0x64, 0x65, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6f, 0x6c, 0x64, 0x6e, 0x6f, 0x64, 0x65, 0x69,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x0a,
-	0x0c, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x29, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f,
-	0x75, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x72, 0x0a, 0x0e,
-	0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
-	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x5f,
-	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65,
-	0x64, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x60, 0x0a, 0x0f, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x77,
+	0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x64, 0x69,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e,
+	0x67, 0x22, 0x34, 0x0a, 0x0e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79,
-	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x4f,
-	0x75, 0x74, 0x22, 0x37, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x10, 0x52,
-	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x03, 0x6f, 0x75, 0x74, 0x22, 0x63, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6d, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x34, 0x0a, 0x0e, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x6f, 0x6c, 0x64, 0x6e, 0x6f, 0x64, 0x65, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6f, 0x6c, 0x64, 0x6e, 0x6f, 0x64, 0x65, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x0c, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x72, 0x0a, 0x0e, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x60, 0x0a, 0x0f, 0x53, 0x79, 0x6d,
+	0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06,
 	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70,
 	0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x5f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x74, 0x74,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x74, 0x74, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x65, 0x73,
-	0x74, 0x22, 0x4a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73,
+	0x12, 0x29, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75,
+	0x74, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x37, 0x0a, 0x0f, 0x52,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x22, 0x63,
+	0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x22, 0x34, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x4c, 0x0a,
-	0x10, 0x4c, 0x69, 0x73, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
-	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x11, 0x4c,
-	0x69, 0x73, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5f, 0x0a, 0x0f, 0x47, 0x65, 0x74,
 	0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06,
 	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
 	0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
 	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x74, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x61, 0x74, 0x74, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x36, 0x0a, 0x10, 0x53, 0x65,
+	0x52, 0x04, 0x61, 0x74, 0x74, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x10, 0x47, 0x65,
 	0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
 	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
 	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x4e, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x58, 0x41, 0x74, 0x74,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x74, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x74,
-	0x74, 0x72, 0x22, 0x39, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x58, 0x41, 0x74, 0x74,
+	0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x58, 0x41,
+	0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x58, 0x41, 0x74, 0x74,
 	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61,
 	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa3, 0x01,
-	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
-	0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d,
-	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x75, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64,
-	0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64,
-	0x69, 0x6e, 0x67, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x65, 0x6e,
-	0x74, 0x72, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x74,
-	0x72, 0x79, 0x4f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6f, 0x75,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x32, 0x0a,
-	0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07,
-	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x49,
-	0x6e, 0x22, 0x5a, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6f, 0x75,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x32, 0x0a,
-	0x0b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07,
-	0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49,
-	0x6e, 0x22, 0x4a, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x22, 0xbf, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x74, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x74, 0x74, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x22, 0x36, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4e, 0x0a, 0x12, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x74, 0x74, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x74, 0x74, 0x72, 0x22, 0x39, 0x0a, 0x13, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x75, 0x6d, 0x61,
+	0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x87, 0x01, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x4f, 0x75, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x12, 0x26,
+	0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x6f,
+	0x70, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x32, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x22, 0x5a, 0x0a, 0x0c, 0x4f, 0x70,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26,
+	0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x6f,
+	0x70, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x69, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x49, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x12, 0x35, 0x0a, 0x0d, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x66, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x22, 0x8e, 0x01,
-	0x0a, 0x0c, 0x4c, 0x73, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
-	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x66, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x77, 0x68, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x68,
-	0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x4b,
-	0x0a, 0x0d, 0x4c, 0x73, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x09,
-	0x4c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49,
-	0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x0e, 0x0a, 0x02, 0x66, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x66, 0x68, 0x12,
-	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x02, 0x6c, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52,
-	0x02, 0x6c, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6b, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6c, 0x6b, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
-	0x02, 0x6c, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x46,
-	0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x02, 0x6c, 0x6b, 0x22, 0x33, 0x0a, 0x0d, 0x53,
-	0x65, 0x74, 0x4c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0xa0, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x4c, 0x73,
+	0x65, 0x65, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x66, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x66, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x68, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x68, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x4b, 0x0a, 0x0d, 0x4c, 0x73,
+	0x65, 0x65, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x09, 0x4c, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x66,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x66, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x02, 0x6c, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x02, 0x6c, 0x6b, 0x12,
+	0x19, 0x0a, 0x08, 0x6c, 0x6b, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x6c, 0x6b, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x02, 0x6c, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4c,
+	0x6f, 0x63, 0x6b, 0x52, 0x02, 0x6c, 0x6b, 0x22, 0x33, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x4c, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa0, 0x01, 0x0a,
+	0x0e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x66, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22,
+	0xc7, 0x02, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x66, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70,
+	0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x35, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x75, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x4d, 0x0a, 0x0d, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x22, 0xe0, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x70,
+	0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x05, 0x66, 0x68, 0x5f, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x68, 0x49, 0x6e, 0x12, 0x15, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f, 0x66,
+	0x66, 0x49, 0x6e, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x4f, 0x75, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x66, 0x68, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x68, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x66,
+	0x66, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x4f, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x55, 0x0a, 0x15, 0x43,
+	0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x72, 0x69, 0x74,
+	0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x77, 0x72, 0x69, 0x74, 0x74,
+	0x65, 0x6e, 0x22, 0x95, 0x01, 0x0a, 0x0c, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x66, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
-	0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12,
-	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x46,
-	0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77,
-	0x6e, 0x65, 0x72, 0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x66, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x72,
-	0x69, 0x74, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c,
-	0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x4d, 0x0a, 0x0d, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73,
+	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x66, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x75,
+	0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x6e, 0x75, 0x73, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x0d, 0x46, 0x6c,
+	0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x7f, 0x0a, 0x0c, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x66, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x73, 0x79, 0x6e,
+	0x63, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67,
+	0x22, 0x33, 0x0a, 0x0d, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x10, 0x46, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x66, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x66, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x37,
+	0x0a, 0x11, 0x46, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x35, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x44,
+	0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x22, 0x5d,
+	0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6f, 0x75,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x35, 0x0a,
+	0x0e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x64, 0x49, 0x6e, 0x22, 0x5d, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x66, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x9e, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x61,
+	0x74, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73,
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62,
 	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x07, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x22, 0xe0, 0x01, 0x0a, 0x14, 0x43, 0x6f,
-	0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x05, 0x66, 0x68, 0x5f, 0x69,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x68, 0x49, 0x6e, 0x12, 0x15, 0x0a,
-	0x06, 0x6f, 0x66, 0x66, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6f,
-	0x66, 0x66, 0x49, 0x6e, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f,
-	0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x49,
-	0x64, 0x4f, 0x75, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x66, 0x68, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x68, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6f,
-	0x66, 0x66, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x4f, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x55, 0x0a, 0x15,
-	0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x72, 0x69,
-	0x74, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x77, 0x72, 0x69, 0x74,
-	0x74, 0x65, 0x6e, 0x22, 0x95, 0x01, 0x0a, 0x0c, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x66, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e,
-	0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x6e, 0x75, 0x73,
-	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x0d, 0x46,
-	0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x7f, 0x0a, 0x0c, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x24, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x66, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x73, 0x79, 0x6e, 0x63, 0x5f,
-	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x73, 0x79,
-	0x6e, 0x63, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69,
-	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e,
-	0x67, 0x22, 0x33, 0x0a, 0x0d, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x10, 0x46, 0x61, 0x6c, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62,
-	0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x66,
-	0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
-	0x68, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x22,
-	0x37, 0x0a, 0x11, 0x46, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x35, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e,
-	0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x6f, 0x70,
-	0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x22,
-	0x5d, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6f,
-	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x35,
-	0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x23, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x52, 0x06, 0x72,
-	0x65, 0x61, 0x64, 0x49, 0x6e, 0x22, 0x5d, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x07,
-	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x70, 0x62, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x66, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x9e, 0x02, 0x0a, 0x0e, 0x53, 0x74,
-	0x61, 0x74, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x66, 0x72, 0x65,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x66, 0x72, 0x65, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x62, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x62, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x66, 0x66, 0x72, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x66, 0x72,
-	0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x62, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65,
-	0x4c, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x4c,
-	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x66, 0x72, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
-	0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64,
-	0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x72, 0x65, 0x18, 0x0b, 0x20,
-	0x03, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x70, 0x61, 0x72, 0x65, 0x32, 0xad, 0x0f, 0x0a, 0x0d, 0x52,
-	0x61, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x31, 0x0a, 0x06,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x31, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
-	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70,
-	0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x35, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x70,
-	0x62, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x41, 0x74, 0x74, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x34, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x4d, 0x6b, 0x6e, 0x6f, 0x64, 0x12, 0x10,
-	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6b, 0x6e, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6b, 0x6e, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x12, 0x10,
-	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12,
-	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x52, 0x6d, 0x64, 0x69,
-	0x72, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x06, 0x52, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x04, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x53, 0x79, 0x6d, 0x6c,
-	0x69, 0x6e, 0x6b, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d,
-	0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37,
-	0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x06, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65,
-	0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x58,
+	0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x66, 0x72, 0x65, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x66, 0x72, 0x65, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x66, 0x72, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x66, 0x72, 0x65,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x62, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x4c,
+	0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x4c, 0x65,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x66, 0x72, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x05, 0x73, 0x70, 0x61, 0x72, 0x65, 0x2a, 0xc7, 0x01, 0x0a, 0x07, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45,
+	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x45, 0x41, 0x54, 0x55,
+	0x52, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10,
+	0x01, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x42, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x46, 0x4f, 0x52, 0x47, 0x45, 0x54, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x46,
+	0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x44, 0x49, 0x52, 0x50, 0x4c,
+	0x55, 0x53, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x49, 0x45, 0x53, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e,
+	0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x08,
+	0x12, 0x11, 0x0a, 0x0d, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x53, 0x10, 0x10, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43,
+	0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x20, 0x12, 0x15, 0x0a, 0x11,
+	0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d,
+	0x53, 0x10, 0x40, 0x2a, 0x4b, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x5f,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53,
+	0x55, 0x4d, 0x5f, 0x43, 0x52, 0x43, 0x33, 0x32, 0x43, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x5f, 0x58, 0x58, 0x48, 0x41, 0x53, 0x48, 0x10, 0x02,
+	0x32, 0xca, 0x11, 0x0a, 0x0d, 0x52, 0x61, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x12, 0x31, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x11, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x0f, 0x2e,
+	0x70, 0x62, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x11,
+	0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x06, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x06,
+	0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x6f, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x12,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x53, 0x65,
+	0x74, 0x41, 0x74, 0x74, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x74,
+	0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x2e, 0x0a, 0x05, 0x4d, 0x6b, 0x6e, 0x6f, 0x64, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4d,
+	0x6b, 0x6e, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62,
+	0x2e, 0x4d, 0x6b, 0x6e, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x2e, 0x0a, 0x05, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4d,
+	0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62,
+	0x2e, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x31, 0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e,
+	0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x12, 0x10, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x06, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x11, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x12,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x52, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x31, 0x0a, 0x06, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x11, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x58, 0x41, 0x74, 0x74,
+	0x72, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x58,
+	0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x12, 0x14, 0x2e, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x53, 0x65,
+	0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x58,
 	0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72,
-	0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x37, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x12, 0x13, 0x2e, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x58, 0x41, 0x74, 0x74, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x58, 0x41, 0x74, 0x74, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x06, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a,
-	0x04, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x04, 0x52, 0x65,
-	0x61, 0x64, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x2e, 0x0a, 0x05, 0x4c, 0x73, 0x65,
-	0x65, 0x6b, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x65, 0x65, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x05, 0x47, 0x65, 0x74,
-	0x4c, 0x6b, 0x12, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x05, 0x53, 0x65, 0x74, 0x4c, 0x6b, 0x12,
-	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x4c, 0x6b, 0x77, 0x12, 0x0d, 0x2e,
+	0x2e, 0x53, 0x65, 0x74, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x58, 0x41, 0x74,
+	0x74, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x58, 0x41,
+	0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x58, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
+	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x04, 0x4f, 0x70, 0x65, 0x6e,
+	0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x0f, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x2e, 0x0a, 0x05, 0x4c, 0x73, 0x65, 0x65, 0x6b, 0x12, 0x10, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x73, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x4c, 0x6b, 0x12, 0x0d, 0x2e,
 	0x70, 0x62, 0x2e, 0x4c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x37, 0x0a, 0x07, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x12, 0x2e, 0x70,
-	0x62, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x43, 0x6f,
-	0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x62,
-	0x2e, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x46,
-	0x69, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x10, 0x2e, 0x70, 0x62,
-	0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
-	0x70, 0x62, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x10, 0x2e, 0x70, 0x62,
-	0x2e, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
-	0x70, 0x62, 0x2e, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x46, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x12,
-	0x14, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6c, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34,
-	0x0a, 0x07, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x12,
-	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0b,
-	0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x50, 0x6c, 0x75, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x62,
-	0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0a, 0x52, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x44, 0x69, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x69, 0x72,
-	0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x46,
-	0x73, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x66, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x66, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x69, 0x79, 0x75, 0x74, 0x69,
-	0x61, 0x6e, 0x79, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x66, 0x75, 0x73, 0x65, 0x2f, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x2b, 0x0a, 0x05, 0x53, 0x65, 0x74, 0x4c, 0x6b, 0x12, 0x0d, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x4c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2c,
+	0x0a, 0x06, 0x53, 0x65, 0x74, 0x4c, 0x6b, 0x77, 0x12, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
+	0x4c, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x07,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x10,
+	0x2e, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x46, 0x0a,
+	0x0d, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f,
+	0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x10,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x10,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x46, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x61,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x34, 0x0a, 0x07, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x12, 0x12, 0x2e, 0x70,
+	0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x44,
+	0x69, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x50, 0x6c, 0x75, 0x73, 0x12, 0x12,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0a, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x69, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x46, 0x73, 0x79, 0x6e, 0x63,
+	0x44, 0x69, 0x72, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x73, 0x79, 0x6e, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x46, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x66, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x24, 0x5a,
+	0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x69, 0x79,
+	0x75, 0x74, 0x69, 0x61, 0x6e, 0x79, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x66, 0x75, 0x73, 0x65,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4520,238 +5590,269 @@ func file_raw_file_system_proto_rawDescGZIP() []byte {
 	return file_raw_file_system_proto_rawDescData
 }
 
-var file_raw_file_system_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_raw_file_system_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_raw_file_system_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
 var file_raw_file_system_proto_goTypes = []interface{}{
-	(*StringRequest)(nil),         // 0: pb.StringRequest
-	(*StringResponse)(nil),        // 1: pb.StringResponse
-	(*LookupRequest)(nil),         // 2: pb.LookupRequest
-	(*LookupResponse)(nil),        // 3: pb.LookupResponse
-	(*ForgetRequest)(nil),         // 4: pb.ForgetRequest
-	(*GetAttrRequest)(nil),        // 5: pb.GetAttrRequest
-	(*GetAttrResponse)(nil),       // 6: pb.GetAttrResponse
-	(*SetAttrRequest)(nil),        // 7: pb.SetAttrRequest
-	(*SetAttrResponse)(nil),       // 8: pb.SetAttrResponse
-	(*MknodRequest)(nil),          // 9: pb.MknodRequest
-	(*MknodResponse)(nil),         // 10: pb.MknodResponse
-	(*MkdirRequest)(nil),          // 11: pb.MkdirRequest
-	(*MkdirResponse)(nil),         // 12: pb.MkdirResponse
-	(*UnlinkRequest)(nil),         // 13: pb.UnlinkRequest
-	(*UnlinkResponse)(nil),        // 14: pb.UnlinkResponse
-	(*RmdirRequest)(nil),          // 15: pb.RmdirRequest
-	(*RmdirResponse)(nil),         // 16: pb.RmdirResponse
-	(*RenameRequest)(nil),         // 17: pb.RenameRequest
-	(*RenameResponse)(nil),        // 18: pb.RenameResponse
-	(*LinkRequest)(nil),           // 19: pb.LinkRequest
-	(*LinkResponse)(nil),          // 20: pb.LinkResponse
-	(*SymlinkRequest)(nil),        // 21: pb.SymlinkRequest
-	(*SymlinkResponse)(nil),       // 22: pb.SymlinkResponse
-	(*ReadlinkRequest)(nil),       // 23: pb.ReadlinkRequest
-	(*ReadlinkResponse)(nil),      // 24: pb.ReadlinkResponse
-	(*AccessRequest)(nil),         // 25: pb.AccessRequest
-	(*AccessResponse)(nil),        // 26: pb.AccessResponse
-	(*GetXAttrRequest)(nil),       // 27: pb.GetXAttrRequest
-	(*GetXAttrResponse)(nil),      // 28: pb.GetXAttrResponse
-	(*ListXAttrRequest)(nil),      // 29: pb.ListXAttrRequest
-	(*ListXAttrResponse)(nil),     // 30: pb.ListXAttrResponse
-	(*SetXAttrRequest)(nil),       // 31: pb.SetXAttrRequest
-	(*SetXAttrResponse)(nil),      // 32: pb.SetXAttrResponse
-	(*RemoveXAttrRequest)(nil),    // 33: pb.RemoveXAttrRequest
-	(*RemoveXAttrResponse)(nil),   // 34: pb.RemoveXAttrResponse
-	(*CreateRequest)(nil),         // 35: pb.CreateRequest
-	(*CreateResponse)(nil),        // 36: pb.CreateResponse
-	(*OpenRequest)(nil),           // 37: pb.OpenRequest
-	(*OpenResponse)(nil),          // 38: pb.OpenResponse
-	(*ReadRequest)(nil),           // 39: pb.ReadRequest
-	(*ReadResponse)(nil),          // 40: pb.ReadResponse
-	(*LseekRequest)(nil),          // 41: pb.LseekRequest
-	(*LseekResponse)(nil),         // 42: pb.LseekResponse
-	(*LkRequest)(nil),             // 43: pb.LkRequest
-	(*GetLkResponse)(nil),         // 44: pb.GetLkResponse
-	(*SetLkResponse)(nil),         // 45: pb.SetLkResponse
-	(*ReleaseRequest)(nil),        // 46: pb.ReleaseRequest
-	(*WriteRequest)(nil),          // 47: pb.WriteRequest
-	(*WriteResponse)(nil),         // 48: pb.WriteResponse
-	(*CopyFileRangeRequest)(nil),  // 49: pb.CopyFileRangeRequest
-	(*CopyFileRangeResponse)(nil), // 50: pb.CopyFileRangeResponse
-	(*FlushRequest)(nil),          // 51: pb.FlushRequest
-	(*FlushResponse)(nil),         // 52: pb.FlushResponse
-	(*FsyncRequest)(nil),          // 53: pb.FsyncRequest
-	(*FsyncResponse)(nil),         // 54: pb.FsyncResponse
-	(*FallocateRequest)(nil),      // 55: pb.FallocateRequest
-	(*FallocateResponse)(nil),     // 56: pb.FallocateResponse
-	(*OpenDirRequest)(nil),        // 57: pb.OpenDirRequest
-	(*OpenDirResponse)(nil),       // 58: pb.OpenDirResponse
-	(*ReadDirRequest)(nil),        // 59: pb.ReadDirRequest
-	(*ReadDirResponse)(nil),       // 60: pb.ReadDirResponse
-	(*StatfsRequest)(nil),         // 61: pb.StatfsRequest
-	(*StatfsResponse)(nil),        // 62: pb.StatfsResponse
-	(*InHeader)(nil),              // 63: pb.InHeader
-	(*Status)(nil),                // 64: pb.Status
-	(*EntryOut)(nil),              // 65: pb.EntryOut
-	(*AttrOut)(nil),               // 66: pb.AttrOut
-	(*Owner)(nil),                 // 67: pb.Owner
-	(*OpenOut)(nil),               // 68: pb.OpenOut
-	(*OpenIn)(nil),                // 69: pb.OpenIn
-	(*ReadIn)(nil),                // 70: pb.ReadIn
-	(*FileLock)(nil),              // 71: pb.FileLock
-	(*DirEntry)(nil),              // 72: pb.DirEntry
-	(*emptypb.Empty)(nil),         // 73: google.protobuf.Empty
+	(Feature)(0),                  // 0: pb.Feature
+	(ChecksumType)(0),             // 1: pb.ChecksumType
+	(*StringRequest)(nil),         // 2: pb.StringRequest
+	(*StringResponse)(nil),        // 3: pb.StringResponse
+	(*KernelSettings)(nil),        // 4: pb.KernelSettings
+	(*MountOptions)(nil),          // 5: pb.MountOptions
+	(*InitRequest)(nil),           // 6: pb.InitRequest
+	(*InitResponse)(nil),          // 7: pb.InitResponse
+	(*ListExportsRequest)(nil),    // 8: pb.ListExportsRequest
+	(*ListExportsResponse)(nil),   // 9: pb.ListExportsResponse
+	(*NotifyRequest)(nil),         // 10: pb.NotifyRequest
+	(*InodeNotify)(nil),           // 11: pb.InodeNotify
+	(*EntryNotify)(nil),           // 12: pb.EntryNotify
+	(*NotifyResponse)(nil),        // 13: pb.NotifyResponse
+	(*LookupRequest)(nil),         // 14: pb.LookupRequest
+	(*LookupResponse)(nil),        // 15: pb.LookupResponse
+	(*ForgetRequest)(nil),         // 16: pb.ForgetRequest
+	(*BatchForgetRequest)(nil),    // 17: pb.BatchForgetRequest
+	(*GetAttrRequest)(nil),        // 18: pb.GetAttrRequest
+	(*GetAttrResponse)(nil),       // 19: pb.GetAttrResponse
+	(*SetAttrRequest)(nil),        // 20: pb.SetAttrRequest
+	(*SetAttrResponse)(nil),       // 21: pb.SetAttrResponse
+	(*MknodRequest)(nil),          // 22: pb.MknodRequest
+	(*MknodResponse)(nil),         // 23: pb.MknodResponse
+	(*MkdirRequest)(nil),          // 24: pb.MkdirRequest
+	(*MkdirResponse)(nil),         // 25: pb.MkdirResponse
+	(*UnlinkRequest)(nil),         // 26: pb.UnlinkRequest
+	(*UnlinkResponse)(nil),        // 27: pb.UnlinkResponse
+	(*RmdirRequest)(nil),          // 28: pb.RmdirRequest
+	(*RmdirResponse)(nil),         // 29: pb.RmdirResponse
+	(*RenameRequest)(nil),         // 30: pb.RenameRequest
+	(*RenameResponse)(nil),        // 31: pb.RenameResponse
+	(*LinkRequest)(nil),           // 32: pb.LinkRequest
+	(*LinkResponse)(nil),          // 33: pb.LinkResponse
+	(*SymlinkRequest)(nil),        // 34: pb.SymlinkRequest
+	(*SymlinkResponse)(nil),       // 35: pb.SymlinkResponse
+	(*ReadlinkRequest)(nil),       // 36: pb.ReadlinkRequest
+	(*ReadlinkResponse)(nil),      // 37: pb.ReadlinkResponse
+	(*AccessRequest)(nil),         // 38: pb.AccessRequest
+	(*AccessResponse)(nil),        // 39: pb.AccessResponse
+	(*GetXAttrRequest)(nil),       // 40: pb.GetXAttrRequest
+	(*GetXAttrResponse)(nil),      // 41: pb.GetXAttrResponse
+	(*ListXAttrRequest)(nil),      // 42: pb.ListXAttrRequest
+	(*ListXAttrResponse)(nil),     // 43: pb.ListXAttrResponse
+	(*SetXAttrRequest)(nil),       // 44: pb.SetXAttrRequest
+	(*SetXAttrResponse)(nil),      // 45: pb.SetXAttrResponse
+	(*RemoveXAttrRequest)(nil),    // 46: pb.RemoveXAttrRequest
+	(*RemoveXAttrResponse)(nil),   // 47: pb.RemoveXAttrResponse
+	(*CreateRequest)(nil),         // 48: pb.CreateRequest
+	(*CreateResponse)(nil),        // 49: pb.CreateResponse
+	(*OpenRequest)(nil),           // 50: pb.OpenRequest
+	(*OpenResponse)(nil),          // 51: pb.OpenResponse
+	(*ReadRequest)(nil),           // 52: pb.ReadRequest
+	(*ReadResponse)(nil),          // 53: pb.ReadResponse
+	(*LseekRequest)(nil),          // 54: pb.LseekRequest
+	(*LseekResponse)(nil),         // 55: pb.LseekResponse
+	(*LkRequest)(nil),             // 56: pb.LkRequest
+	(*GetLkResponse)(nil),         // 57: pb.GetLkResponse
+	(*SetLkResponse)(nil),         // 58: pb.SetLkResponse
+	(*ReleaseRequest)(nil),        // 59: pb.ReleaseRequest
+	(*WriteRequest)(nil),          // 60: pb.WriteRequest
+	(*WriteResponse)(nil),         // 61: pb.WriteResponse
+	(*CopyFileRangeRequest)(nil),  // 62: pb.CopyFileRangeRequest
+	(*CopyFileRangeResponse)(nil), // 63: pb.CopyFileRangeResponse
+	(*FlushRequest)(nil),          // 64: pb.FlushRequest
+	(*FlushResponse)(nil),         // 65: pb.FlushResponse
+	(*FsyncRequest)(nil),          // 66: pb.FsyncRequest
+	(*FsyncResponse)(nil),         // 67: pb.FsyncResponse
+	(*FallocateRequest)(nil),      // 68: pb.FallocateRequest
+	(*FallocateResponse)(nil),     // 69: pb.FallocateResponse
+	(*OpenDirRequest)(nil),        // 70: pb.OpenDirRequest
+	(*OpenDirResponse)(nil),       // 71: pb.OpenDirResponse
+	(*ReadDirRequest)(nil),        // 72: pb.ReadDirRequest
+	(*ReadDirResponse)(nil),       // 73: pb.ReadDirResponse
+	(*StatfsRequest)(nil),         // 74: pb.StatfsRequest
+	(*StatfsResponse)(nil),        // 75: pb.StatfsResponse
+	(*InHeader)(nil),              // 76: pb.InHeader
+	(*Status)(nil),                // 77: pb.Status
+	(*EntryOut)(nil),              // 78: pb.EntryOut
+	(*AttrOut)(nil),               // 79: pb.AttrOut
+	(*Owner)(nil),                 // 80: pb.Owner
+	(*OpenOut)(nil),               // 81: pb.OpenOut
+	(*OpenIn)(nil),                // 82: pb.OpenIn
+	(*ReadIn)(nil),                // 83: pb.ReadIn
+	(*FileLock)(nil),              // 84: pb.FileLock
+	(*DirEntry)(nil),              // 85: pb.DirEntry
+	(*emptypb.Empty)(nil),         // 86: google.protobuf.Empty
 }
 var file_raw_file_system_proto_depIdxs = []int32{
-	63,  // 0: pb.LookupRequest.header:type_name -> pb.InHeader
-	64,  // 1: pb.LookupResponse.status:type_name -> pb.Status
-	65,  // 2: pb.LookupResponse.entry_out:type_name -> pb.EntryOut
-	63,  // 3: pb.GetAttrRequest.header:type_name -> pb.InHeader
-	64,  // 4: pb.GetAttrResponse.status:type_name -> pb.Status
-	66,  // 5: pb.GetAttrResponse.attr_out:type_name -> pb.AttrOut
-	63,  // 6: pb.SetAttrRequest.header:type_name -> pb.InHeader
-	67,  // 7: pb.SetAttrRequest.owner:type_name -> pb.Owner
-	64,  // 8: pb.SetAttrResponse.status:type_name -> pb.Status
-	66,  // 9: pb.SetAttrResponse.attr_out:type_name -> pb.AttrOut
-	63,  // 10: pb.MknodRequest.header:type_name -> pb.InHeader
-	64,  // 11: pb.MknodResponse.status:type_name -> pb.Status
-	65,  // 12: pb.MknodResponse.entry_out:type_name -> pb.EntryOut
-	63,  // 13: pb.MkdirRequest.header:type_name -> pb.InHeader
-	64,  // 14: pb.MkdirResponse.status:type_name -> pb.Status
-	65,  // 15: pb.MkdirResponse.entry_out:type_name -> pb.EntryOut
-	63,  // 16: pb.UnlinkRequest.header:type_name -> pb.InHeader
-	64,  // 17: pb.UnlinkResponse.status:type_name -> pb.Status
-	63,  // 18: pb.RmdirRequest.header:type_name -> pb.InHeader
-	64,  // 19: pb.RmdirResponse.status:type_name -> pb.Status
-	63,  // 20: pb.RenameRequest.header:type_name -> pb.InHeader
-	64,  // 21: pb.RenameResponse.status:type_name -> pb.Status
-	63,  // 22: pb.LinkRequest.header:type_name -> pb.InHeader
-	64,  // 23: pb.LinkResponse.status:type_name -> pb.Status
-	65,  // 24: pb.LinkResponse.entry_out:type_name -> pb.EntryOut
-	63,  // 25: pb.SymlinkRequest.header:type_name -> pb.InHeader
-	64,  // 26: pb.SymlinkResponse.status:type_name -> pb.Status
-	65,  // 27: pb.SymlinkResponse.entry_out:type_name -> pb.EntryOut
-	63,  // 28: pb.ReadlinkRequest.header:type_name -> pb.InHeader
-	64,  // 29: pb.ReadlinkResponse.status:type_name -> pb.Status
-	63,  // 30: pb.AccessRequest.header:type_name -> pb.InHeader
-	64,  // 31: pb.AccessResponse.status:type_name -> pb.Status
-	63,  // 32: pb.GetXAttrRequest.header:type_name -> pb.InHeader
-	64,  // 33: pb.GetXAttrResponse.status:type_name -> pb.Status
-	63,  // 34: pb.ListXAttrRequest.header:type_name -> pb.InHeader
-	64,  // 35: pb.ListXAttrResponse.status:type_name -> pb.Status
-	63,  // 36: pb.SetXAttrRequest.header:type_name -> pb.InHeader
-	64,  // 37: pb.SetXAttrResponse.status:type_name -> pb.Status
-	63,  // 38: pb.RemoveXAttrRequest.header:type_name -> pb.InHeader
-	64,  // 39: pb.RemoveXAttrResponse.status:type_name -> pb.Status
-	63,  // 40: pb.CreateRequest.header:type_name -> pb.InHeader
-	64,  // 41: pb.CreateResponse.status:type_name -> pb.Status
-	65,  // 42: pb.CreateResponse.entry_out:type_name -> pb.EntryOut
-	68,  // 43: pb.CreateResponse.open_out:type_name -> pb.OpenOut
-	69,  // 44: pb.OpenRequest.open_in:type_name -> pb.OpenIn
-	64,  // 45: pb.OpenResponse.status:type_name -> pb.Status
-	68,  // 46: pb.OpenResponse.open_out:type_name -> pb.OpenOut
-	70,  // 47: pb.ReadRequest.read_in:type_name -> pb.ReadIn
-	64,  // 48: pb.ReadResponse.status:type_name -> pb.Status
-	63,  // 49: pb.LseekRequest.header:type_name -> pb.InHeader
-	64,  // 50: pb.LseekResponse.status:type_name -> pb.Status
-	63,  // 51: pb.LkRequest.header:type_name -> pb.InHeader
-	71,  // 52: pb.LkRequest.lk:type_name -> pb.FileLock
-	64,  // 53: pb.GetLkResponse.status:type_name -> pb.Status
-	71,  // 54: pb.GetLkResponse.lk:type_name -> pb.FileLock
-	64,  // 55: pb.SetLkResponse.status:type_name -> pb.Status
-	63,  // 56: pb.ReleaseRequest.header:type_name -> pb.InHeader
-	63,  // 57: pb.WriteRequest.header:type_name -> pb.InHeader
-	64,  // 58: pb.WriteResponse.status:type_name -> pb.Status
-	63,  // 59: pb.CopyFileRangeRequest.header:type_name -> pb.InHeader
-	64,  // 60: pb.CopyFileRangeResponse.status:type_name -> pb.Status
-	63,  // 61: pb.FlushRequest.header:type_name -> pb.InHeader
-	64,  // 62: pb.FlushResponse.status:type_name -> pb.Status
-	63,  // 63: pb.FsyncRequest.header:type_name -> pb.InHeader
-	64,  // 64: pb.FsyncResponse.status:type_name -> pb.Status
-	63,  // 65: pb.FallocateRequest.header:type_name -> pb.InHeader
-	64,  // 66: pb.FallocateResponse.status:type_name -> pb.Status
-	69,  // 67: pb.OpenDirRequest.open_in:type_name -> pb.OpenIn
-	64,  // 68: pb.OpenDirResponse.status:type_name -> pb.Status
-	68,  // 69: pb.OpenDirResponse.open_out:type_name -> pb.OpenOut
-	70,  // 70: pb.ReadDirRequest.read_in:type_name -> pb.ReadIn
-	64,  // 71: pb.ReadDirResponse.status:type_name -> pb.Status
-	72,  // 72: pb.ReadDirResponse.entries:type_name -> pb.DirEntry
-	63,  // 73: pb.StatfsRequest.input:type_name -> pb.InHeader
-	64,  // 74: pb.StatfsResponse.status:type_name -> pb.Status
-	0,   // 75: pb.RawFileSystem.String:input_type -> pb.StringRequest
-	2,   // 76: pb.RawFileSystem.Lookup:input_type -> pb.LookupRequest
-	4,   // 77: pb.RawFileSystem.Forget:input_type -> pb.ForgetRequest
-	5,   // 78: pb.RawFileSystem.GetAttr:input_type -> pb.GetAttrRequest
-	7,   // 79: pb.RawFileSystem.SetAttr:input_type -> pb.SetAttrRequest
-	9,   // 80: pb.RawFileSystem.Mknod:input_type -> pb.MknodRequest
-	11,  // 81: pb.RawFileSystem.Mkdir:input_type -> pb.MkdirRequest
-	13,  // 82: pb.RawFileSystem.Unlink:input_type -> pb.UnlinkRequest
-	15,  // 83: pb.RawFileSystem.Rmdir:input_type -> pb.RmdirRequest
-	17,  // 84: pb.RawFileSystem.Rename:input_type -> pb.RenameRequest
-	19,  // 85: pb.RawFileSystem.Link:input_type -> pb.LinkRequest
-	21,  // 86: pb.RawFileSystem.Symlink:input_type -> pb.SymlinkRequest
-	23,  // 87: pb.RawFileSystem.Readlink:input_type -> pb.ReadlinkRequest
-	25,  // 88: pb.RawFileSystem.Access:input_type -> pb.AccessRequest
-	27,  // 89: pb.RawFileSystem.GetXAttr:input_type -> pb.GetXAttrRequest
-	29,  // 90: pb.RawFileSystem.ListXAttr:input_type -> pb.ListXAttrRequest
-	31,  // 91: pb.RawFileSystem.SetXAttr:input_type -> pb.SetXAttrRequest
-	33,  // 92: pb.RawFileSystem.RemoveXAttr:input_type -> pb.RemoveXAttrRequest
-	35,  // 93: pb.RawFileSystem.Create:input_type -> pb.CreateRequest
-	37,  // 94: pb.RawFileSystem.Open:input_type -> pb.OpenRequest
-	39,  // 95: pb.RawFileSystem.Read:input_type -> pb.ReadRequest
-	41,  // 96: pb.RawFileSystem.Lseek:input_type -> pb.LseekRequest
-	43,  // 97: pb.RawFileSystem.GetLk:input_type -> pb.LkRequest
-	43,  // 98: pb.RawFileSystem.SetLk:input_type -> pb.LkRequest
-	43,  // 99: pb.RawFileSystem.SetLkw:input_type -> pb.LkRequest
-	46,  // 100: pb.RawFileSystem.Release:input_type -> pb.ReleaseRequest
-	47,  // 101: pb.RawFileSystem.Write:input_type -> pb.WriteRequest
-	49,  // 102: pb.RawFileSystem.CopyFileRange:input_type -> pb.CopyFileRangeRequest
-	51,  // 103: pb.RawFileSystem.Flush:input_type -> pb.FlushRequest
-	53,  // 104: pb.RawFileSystem.Fsync:input_type -> pb.FsyncRequest
-	55,  // 105: pb.RawFileSystem.Fallocate:input_type -> pb.FallocateRequest
-	57,  // 106: pb.RawFileSystem.OpenDir:input_type -> pb.OpenDirRequest
-	59,  // 107: pb.RawFileSystem.ReadDir:input_type -> pb.ReadDirRequest
-	59,  // 108: pb.RawFileSystem.ReadDirPlus:input_type -> pb.ReadDirRequest
-	46,  // 109: pb.RawFileSystem.ReleaseDir:input_type -> pb.ReleaseRequest
-	53,  // 110: pb.RawFileSystem.FsyncDir:input_type -> pb.FsyncRequest
-	61,  // 111: pb.RawFileSystem.StatFs:input_type -> pb.StatfsRequest
-	1,   // 112: pb.RawFileSystem.String:output_type -> pb.StringResponse
-	3,   // 113: pb.RawFileSystem.Lookup:output_type -> pb.LookupResponse
-	73,  // 114: pb.RawFileSystem.Forget:output_type -> google.protobuf.Empty
-	6,   // 115: pb.RawFileSystem.GetAttr:output_type -> pb.GetAttrResponse
-	8,   // 116: pb.RawFileSystem.SetAttr:output_type -> pb.SetAttrResponse
-	10,  // 117: pb.RawFileSystem.Mknod:output_type -> pb.MknodResponse
-	12,  // 118: pb.RawFileSystem.Mkdir:output_type -> pb.MkdirResponse
-	14,  // 119: pb.RawFileSystem.Unlink:output_type -> pb.UnlinkResponse
-	16,  // 120: pb.RawFileSystem.Rmdir:output_type -> pb.RmdirResponse
-	18,  // 121: pb.RawFileSystem.Rename:output_type -> pb.RenameResponse
-	20,  // 122: pb.RawFileSystem.Link:output_type -> pb.LinkResponse
-	22,  // 123: pb.RawFileSystem.Symlink:output_type -> pb.SymlinkResponse
-	24,  // 124: pb.RawFileSystem.Readlink:output_type -> pb.ReadlinkResponse
-	26,  // 125: pb.RawFileSystem.Access:output_type -> pb.AccessResponse
-	28,  // 126: pb.RawFileSystem.GetXAttr:output_type -> pb.GetXAttrResponse
-	30,  // 127: pb.RawFileSystem.ListXAttr:output_type -> pb.ListXAttrResponse
-	32,  // 128: pb.RawFileSystem.SetXAttr:output_type -> pb.SetXAttrResponse
-	34,  // 129: pb.RawFileSystem.RemoveXAttr:output_type -> pb.RemoveXAttrResponse
-	36,  // 130: pb.RawFileSystem.Create:output_type -> pb.CreateResponse
-	38,  // 131: pb.RawFileSystem.Open:output_type -> pb.OpenResponse
-	40,  // 132: pb.RawFileSystem.Read:output_type -> pb.ReadResponse
-	42,  // 133: pb.RawFileSystem.Lseek:output_type -> pb.LseekResponse
-	44,  // 134: pb.RawFileSystem.GetLk:output_type -> pb.GetLkResponse
-	45,  // 135: pb.RawFileSystem.SetLk:output_type -> pb.SetLkResponse
-	45,  // 136: pb.RawFileSystem.SetLkw:output_type -> pb.SetLkResponse
-	73,  // 137: pb.RawFileSystem.Release:output_type -> google.protobuf.Empty
-	48,  // 138: pb.RawFileSystem.Write:output_type -> pb.WriteResponse
-	50,  // 139: pb.RawFileSystem.CopyFileRange:output_type -> pb.CopyFileRangeResponse
-	52,  // 140: pb.RawFileSystem.Flush:output_type -> pb.FlushResponse
-	54,  // 141: pb.RawFileSystem.Fsync:output_type -> pb.FsyncResponse
-	56,  // 142: pb.RawFileSystem.Fallocate:output_type -> pb.FallocateResponse
-	58,  // 143: pb.RawFileSystem.OpenDir:output_type -> pb.OpenDirResponse
-	60,  // 144: pb.RawFileSystem.ReadDir:output_type -> pb.ReadDirResponse
-	60,  // 145: pb.RawFileSystem.ReadDirPlus:output_type -> pb.ReadDirResponse
-	73,  // 146: pb.RawFileSystem.ReleaseDir:output_type -> google.protobuf.Empty
-	54,  // 147: pb.RawFileSystem.FsyncDir:output_type -> pb.FsyncResponse
-	62,  // 148: pb.RawFileSystem.StatFs:output_type -> pb.StatfsResponse
-	112, // [112:149] is the sub-list for method output_type
-	75,  // [75:112] is the sub-list for method input_type
-	75,  // [75:75] is the sub-list for extension type_name
-	75,  // [75:75] is the sub-list for extension extendee
-	0,   // [0:75] is the sub-list for field type_name
+	4,   // 0: pb.InitRequest.kernel:type_name -> pb.KernelSettings
+	5,   // 1: pb.InitRequest.mount_options:type_name -> pb.MountOptions
+	11,  // 2: pb.NotifyResponse.inode:type_name -> pb.InodeNotify
+	12,  // 3: pb.NotifyResponse.entry:type_name -> pb.EntryNotify
+	76,  // 4: pb.LookupRequest.header:type_name -> pb.InHeader
+	77,  // 5: pb.LookupResponse.status:type_name -> pb.Status
+	78,  // 6: pb.LookupResponse.entry_out:type_name -> pb.EntryOut
+	16,  // 7: pb.BatchForgetRequest.forgets:type_name -> pb.ForgetRequest
+	76,  // 8: pb.GetAttrRequest.header:type_name -> pb.InHeader
+	77,  // 9: pb.GetAttrResponse.status:type_name -> pb.Status
+	79,  // 10: pb.GetAttrResponse.attr_out:type_name -> pb.AttrOut
+	76,  // 11: pb.SetAttrRequest.header:type_name -> pb.InHeader
+	80,  // 12: pb.SetAttrRequest.owner:type_name -> pb.Owner
+	77,  // 13: pb.SetAttrResponse.status:type_name -> pb.Status
+	79,  // 14: pb.SetAttrResponse.attr_out:type_name -> pb.AttrOut
+	76,  // 15: pb.MknodRequest.header:type_name -> pb.InHeader
+	77,  // 16: pb.MknodResponse.status:type_name -> pb.Status
+	78,  // 17: pb.MknodResponse.entry_out:type_name -> pb.EntryOut
+	76,  // 18: pb.MkdirRequest.header:type_name -> pb.InHeader
+	77,  // 19: pb.MkdirResponse.status:type_name -> pb.Status
+	78,  // 20: pb.MkdirResponse.entry_out:type_name -> pb.EntryOut
+	76,  // 21: pb.UnlinkRequest.header:type_name -> pb.InHeader
+	77,  // 22: pb.UnlinkResponse.status:type_name -> pb.Status
+	76,  // 23: pb.RmdirRequest.header:type_name -> pb.InHeader
+	77,  // 24: pb.RmdirResponse.status:type_name -> pb.Status
+	76,  // 25: pb.RenameRequest.header:type_name -> pb.InHeader
+	77,  // 26: pb.RenameResponse.status:type_name -> pb.Status
+	76,  // 27: pb.LinkRequest.header:type_name -> pb.InHeader
+	77,  // 28: pb.LinkResponse.status:type_name -> pb.Status
+	78,  // 29: pb.LinkResponse.entry_out:type_name -> pb.EntryOut
+	76,  // 30: pb.SymlinkRequest.header:type_name -> pb.InHeader
+	77,  // 31: pb.SymlinkResponse.status:type_name -> pb.Status
+	78,  // 32: pb.SymlinkResponse.entry_out:type_name -> pb.EntryOut
+	76,  // 33: pb.ReadlinkRequest.header:type_name -> pb.InHeader
+	77,  // 34: pb.ReadlinkResponse.status:type_name -> pb.Status
+	76,  // 35: pb.AccessRequest.header:type_name -> pb.InHeader
+	77,  // 36: pb.AccessResponse.status:type_name -> pb.Status
+	76,  // 37: pb.GetXAttrRequest.header:type_name -> pb.InHeader
+	77,  // 38: pb.GetXAttrResponse.status:type_name -> pb.Status
+	76,  // 39: pb.ListXAttrRequest.header:type_name -> pb.InHeader
+	77,  // 40: pb.ListXAttrResponse.status:type_name -> pb.Status
+	76,  // 41: pb.SetXAttrRequest.header:type_name -> pb.InHeader
+	77,  // 42: pb.SetXAttrResponse.status:type_name -> pb.Status
+	76,  // 43: pb.RemoveXAttrRequest.header:type_name -> pb.InHeader
+	77,  // 44: pb.RemoveXAttrResponse.status:type_name -> pb.Status
+	76,  // 45: pb.CreateRequest.header:type_name -> pb.InHeader
+	77,  // 46: pb.CreateResponse.status:type_name -> pb.Status
+	78,  // 47: pb.CreateResponse.entry_out:type_name -> pb.EntryOut
+	81,  // 48: pb.CreateResponse.open_out:type_name -> pb.OpenOut
+	82,  // 49: pb.OpenRequest.open_in:type_name -> pb.OpenIn
+	77,  // 50: pb.OpenResponse.status:type_name -> pb.Status
+	81,  // 51: pb.OpenResponse.open_out:type_name -> pb.OpenOut
+	83,  // 52: pb.ReadRequest.read_in:type_name -> pb.ReadIn
+	1,   // 53: pb.ReadRequest.checksum_type:type_name -> pb.ChecksumType
+	77,  // 54: pb.ReadResponse.status:type_name -> pb.Status
+	76,  // 55: pb.LseekRequest.header:type_name -> pb.InHeader
+	77,  // 56: pb.LseekResponse.status:type_name -> pb.Status
+	76,  // 57: pb.LkRequest.header:type_name -> pb.InHeader
+	84,  // 58: pb.LkRequest.lk:type_name -> pb.FileLock
+	77,  // 59: pb.GetLkResponse.status:type_name -> pb.Status
+	84,  // 60: pb.GetLkResponse.lk:type_name -> pb.FileLock
+	77,  // 61: pb.SetLkResponse.status:type_name -> pb.Status
+	76,  // 62: pb.ReleaseRequest.header:type_name -> pb.InHeader
+	76,  // 63: pb.WriteRequest.header:type_name -> pb.InHeader
+	1,   // 64: pb.WriteRequest.checksum_type:type_name -> pb.ChecksumType
+	77,  // 65: pb.WriteResponse.status:type_name -> pb.Status
+	76,  // 66: pb.CopyFileRangeRequest.header:type_name -> pb.InHeader
+	77,  // 67: pb.CopyFileRangeResponse.status:type_name -> pb.Status
+	76,  // 68: pb.FlushRequest.header:type_name -> pb.InHeader
+	77,  // 69: pb.FlushResponse.status:type_name -> pb.Status
+	76,  // 70: pb.FsyncRequest.header:type_name -> pb.InHeader
+	77,  // 71: pb.FsyncResponse.status:type_name -> pb.Status
+	76,  // 72: pb.FallocateRequest.header:type_name -> pb.InHeader
+	77,  // 73: pb.FallocateResponse.status:type_name -> pb.Status
+	82,  // 74: pb.OpenDirRequest.open_in:type_name -> pb.OpenIn
+	77,  // 75: pb.OpenDirResponse.status:type_name -> pb.Status
+	81,  // 76: pb.OpenDirResponse.open_out:type_name -> pb.OpenOut
+	83,  // 77: pb.ReadDirRequest.read_in:type_name -> pb.ReadIn
+	77,  // 78: pb.ReadDirResponse.status:type_name -> pb.Status
+	85,  // 79: pb.ReadDirResponse.entries:type_name -> pb.DirEntry
+	76,  // 80: pb.StatfsRequest.input:type_name -> pb.InHeader
+	77,  // 81: pb.StatfsResponse.status:type_name -> pb.Status
+	2,   // 82: pb.RawFileSystem.String:input_type -> pb.StringRequest
+	6,   // 83: pb.RawFileSystem.Init:input_type -> pb.InitRequest
+	8,   // 84: pb.RawFileSystem.ListExports:input_type -> pb.ListExportsRequest
+	10,  // 85: pb.RawFileSystem.Notify:input_type -> pb.NotifyRequest
+	14,  // 86: pb.RawFileSystem.Lookup:input_type -> pb.LookupRequest
+	16,  // 87: pb.RawFileSystem.Forget:input_type -> pb.ForgetRequest
+	17,  // 88: pb.RawFileSystem.BatchForget:input_type -> pb.BatchForgetRequest
+	18,  // 89: pb.RawFileSystem.GetAttr:input_type -> pb.GetAttrRequest
+	20,  // 90: pb.RawFileSystem.SetAttr:input_type -> pb.SetAttrRequest
+	22,  // 91: pb.RawFileSystem.Mknod:input_type -> pb.MknodRequest
+	24,  // 92: pb.RawFileSystem.Mkdir:input_type -> pb.MkdirRequest
+	26,  // 93: pb.RawFileSystem.Unlink:input_type -> pb.UnlinkRequest
+	28,  // 94: pb.RawFileSystem.Rmdir:input_type -> pb.RmdirRequest
+	30,  // 95: pb.RawFileSystem.Rename:input_type -> pb.RenameRequest
+	32,  // 96: pb.RawFileSystem.Link:input_type -> pb.LinkRequest
+	34,  // 97: pb.RawFileSystem.Symlink:input_type -> pb.SymlinkRequest
+	36,  // 98: pb.RawFileSystem.Readlink:input_type -> pb.ReadlinkRequest
+	38,  // 99: pb.RawFileSystem.Access:input_type -> pb.AccessRequest
+	40,  // 100: pb.RawFileSystem.GetXAttr:input_type -> pb.GetXAttrRequest
+	42,  // 101: pb.RawFileSystem.ListXAttr:input_type -> pb.ListXAttrRequest
+	44,  // 102: pb.RawFileSystem.SetXAttr:input_type -> pb.SetXAttrRequest
+	46,  // 103: pb.RawFileSystem.RemoveXAttr:input_type -> pb.RemoveXAttrRequest
+	48,  // 104: pb.RawFileSystem.Create:input_type -> pb.CreateRequest
+	50,  // 105: pb.RawFileSystem.Open:input_type -> pb.OpenRequest
+	52,  // 106: pb.RawFileSystem.Read:input_type -> pb.ReadRequest
+	54,  // 107: pb.RawFileSystem.Lseek:input_type -> pb.LseekRequest
+	56,  // 108: pb.RawFileSystem.GetLk:input_type -> pb.LkRequest
+	56,  // 109: pb.RawFileSystem.SetLk:input_type -> pb.LkRequest
+	56,  // 110: pb.RawFileSystem.SetLkw:input_type -> pb.LkRequest
+	59,  // 111: pb.RawFileSystem.Release:input_type -> pb.ReleaseRequest
+	60,  // 112: pb.RawFileSystem.Write:input_type -> pb.WriteRequest
+	60,  // 113: pb.RawFileSystem.WriteStream:input_type -> pb.WriteRequest
+	62,  // 114: pb.RawFileSystem.CopyFileRange:input_type -> pb.CopyFileRangeRequest
+	64,  // 115: pb.RawFileSystem.Flush:input_type -> pb.FlushRequest
+	66,  // 116: pb.RawFileSystem.Fsync:input_type -> pb.FsyncRequest
+	68,  // 117: pb.RawFileSystem.Fallocate:input_type -> pb.FallocateRequest
+	70,  // 118: pb.RawFileSystem.OpenDir:input_type -> pb.OpenDirRequest
+	72,  // 119: pb.RawFileSystem.ReadDir:input_type -> pb.ReadDirRequest
+	72,  // 120: pb.RawFileSystem.ReadDirPlus:input_type -> pb.ReadDirRequest
+	59,  // 121: pb.RawFileSystem.ReleaseDir:input_type -> pb.ReleaseRequest
+	66,  // 122: pb.RawFileSystem.FsyncDir:input_type -> pb.FsyncRequest
+	74,  // 123: pb.RawFileSystem.StatFs:input_type -> pb.StatfsRequest
+	3,   // 124: pb.RawFileSystem.String:output_type -> pb.StringResponse
+	7,   // 125: pb.RawFileSystem.Init:output_type -> pb.InitResponse
+	9,   // 126: pb.RawFileSystem.ListExports:output_type -> pb.ListExportsResponse
+	13,  // 127: pb.RawFileSystem.Notify:output_type -> pb.NotifyResponse
+	15,  // 128: pb.RawFileSystem.Lookup:output_type -> pb.LookupResponse
+	86,  // 129: pb.RawFileSystem.Forget:output_type -> google.protobuf.Empty
+	86,  // 130: pb.RawFileSystem.BatchForget:output_type -> google.protobuf.Empty
+	19,  // 131: pb.RawFileSystem.GetAttr:output_type -> pb.GetAttrResponse
+	21,  // 132: pb.RawFileSystem.SetAttr:output_type -> pb.SetAttrResponse
+	23,  // 133: pb.RawFileSystem.Mknod:output_type -> pb.MknodResponse
+	25,  // 134: pb.RawFileSystem.Mkdir:output_type -> pb.MkdirResponse
+	27,  // 135: pb.RawFileSystem.Unlink:output_type -> pb.UnlinkResponse
+	29,  // 136: pb.RawFileSystem.Rmdir:output_type -> pb.RmdirResponse
+	31,  // 137: pb.RawFileSystem.Rename:output_type -> pb.RenameResponse
+	33,  // 138: pb.RawFileSystem.Link:output_type -> pb.LinkResponse
+	35,  // 139: pb.RawFileSystem.Symlink:output_type -> pb.SymlinkResponse
+	37,  // 140: pb.RawFileSystem.Readlink:output_type -> pb.ReadlinkResponse
+	39,  // 141: pb.RawFileSystem.Access:output_type -> pb.AccessResponse
+	41,  // 142: pb.RawFileSystem.GetXAttr:output_type -> pb.GetXAttrResponse
+	43,  // 143: pb.RawFileSystem.ListXAttr:output_type -> pb.ListXAttrResponse
+	45,  // 144: pb.RawFileSystem.SetXAttr:output_type -> pb.SetXAttrResponse
+	47,  // 145: pb.RawFileSystem.RemoveXAttr:output_type -> pb.RemoveXAttrResponse
+	49,  // 146: pb.RawFileSystem.Create:output_type -> pb.CreateResponse
+	51,  // 147: pb.RawFileSystem.Open:output_type -> pb.OpenResponse
+	53,  // 148: pb.RawFileSystem.Read:output_type -> pb.ReadResponse
+	55,  // 149: pb.RawFileSystem.Lseek:output_type -> pb.LseekResponse
+	57,  // 150: pb.RawFileSystem.GetLk:output_type -> pb.GetLkResponse
+	58,  // 151: pb.RawFileSystem.SetLk:output_type -> pb.SetLkResponse
+	58,  // 152: pb.RawFileSystem.SetLkw:output_type -> pb.SetLkResponse
+	86,  // 153: pb.RawFileSystem.Release:output_type -> google.protobuf.Empty
+	61,  // 154: pb.RawFileSystem.Write:output_type -> pb.WriteResponse
+	61,  // 155: pb.RawFileSystem.WriteStream:output_type -> pb.WriteResponse
+	63,  // 156: pb.RawFileSystem.CopyFileRange:output_type -> pb.CopyFileRangeResponse
+	65,  // 157: pb.RawFileSystem.Flush:output_type -> pb.FlushResponse
+	67,  // 158: pb.RawFileSystem.Fsync:output_type -> pb.FsyncResponse
+	69,  // 159: pb.RawFileSystem.Fallocate:output_type -> pb.FallocateResponse
+	71,  // 160: pb.RawFileSystem.OpenDir:output_type -> pb.OpenDirResponse
+	73,  // 161: pb.RawFileSystem.ReadDir:output_type -> pb.ReadDirResponse
+	73,  // 162: pb.RawFileSystem.ReadDirPlus:output_type -> pb.ReadDirResponse
+	86,  // 163: pb.RawFileSystem.ReleaseDir:output_type -> google.protobuf.Empty
+	67,  // 164: pb.RawFileSystem.FsyncDir:output_type -> pb.FsyncResponse
+	75,  // 165: pb.RawFileSystem.StatFs:output_type -> pb.StatfsResponse
+	124, // [124:166] is the sub-list for method output_type
+	82,  // [82:124] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
 }
 
 func init() { file_raw_file_system_proto_init() }
@@ -4786,7 +5887,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LookupRequest); i {
+			switch v := v.(*KernelSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4798,7 +5899,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LookupResponse); i {
+			switch v := v.(*MountOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4810,7 +5911,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForgetRequest); i {
+			switch v := v.(*InitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4822,7 +5923,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAttrRequest); i {
+			switch v := v.(*InitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4834,7 +5935,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAttrResponse); i {
+			switch v := v.(*ListExportsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4846,7 +5947,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetAttrRequest); i {
+			switch v := v.(*ListExportsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4858,7 +5959,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetAttrResponse); i {
+			switch v := v.(*NotifyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4870,7 +5971,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MknodRequest); i {
+			switch v := v.(*InodeNotify); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4882,7 +5983,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MknodResponse); i {
+			switch v := v.(*EntryNotify); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4894,7 +5995,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MkdirRequest); i {
+			switch v := v.(*NotifyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4906,7 +6007,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MkdirResponse); i {
+			switch v := v.(*LookupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4918,7 +6019,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnlinkRequest); i {
+			switch v := v.(*LookupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4930,7 +6031,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnlinkResponse); i {
+			switch v := v.(*ForgetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4942,7 +6043,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RmdirRequest); i {
+			switch v := v.(*BatchForgetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4954,7 +6055,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RmdirResponse); i {
+			switch v := v.(*GetAttrRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4966,7 +6067,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameRequest); i {
+			switch v := v.(*GetAttrResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4978,7 +6079,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameResponse); i {
+			switch v := v.(*SetAttrRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4990,7 +6091,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LinkRequest); i {
+			switch v := v.(*SetAttrResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5002,7 +6103,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LinkResponse); i {
+			switch v := v.(*MknodRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5014,7 +6115,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SymlinkRequest); i {
+			switch v := v.(*MknodResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5026,7 +6127,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SymlinkResponse); i {
+			switch v := v.(*MkdirRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5038,7 +6139,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadlinkRequest); i {
+			switch v := v.(*MkdirResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5050,7 +6151,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadlinkResponse); i {
+			switch v := v.(*UnlinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5062,7 +6163,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessRequest); i {
+			switch v := v.(*UnlinkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5074,7 +6175,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessResponse); i {
+			switch v := v.(*RmdirRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5086,7 +6187,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetXAttrRequest); i {
+			switch v := v.(*RmdirResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5098,7 +6199,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetXAttrResponse); i {
+			switch v := v.(*RenameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5110,7 +6211,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListXAttrRequest); i {
+			switch v := v.(*RenameResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5122,7 +6223,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListXAttrResponse); i {
+			switch v := v.(*LinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5134,7 +6235,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetXAttrRequest); i {
+			switch v := v.(*LinkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5146,7 +6247,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetXAttrResponse); i {
+			switch v := v.(*SymlinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5158,7 +6259,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveXAttrRequest); i {
+			switch v := v.(*SymlinkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5170,7 +6271,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveXAttrResponse); i {
+			switch v := v.(*ReadlinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5182,7 +6283,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRequest); i {
+			switch v := v.(*ReadlinkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5194,7 +6295,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateResponse); i {
+			switch v := v.(*AccessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5206,7 +6307,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenRequest); i {
+			switch v := v.(*AccessResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5218,7 +6319,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenResponse); i {
+			switch v := v.(*GetXAttrRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5230,7 +6331,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadRequest); i {
+			switch v := v.(*GetXAttrResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5242,7 +6343,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadResponse); i {
+			switch v := v.(*ListXAttrRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5254,7 +6355,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LseekRequest); i {
+			switch v := v.(*ListXAttrResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5266,7 +6367,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LseekResponse); i {
+			switch v := v.(*SetXAttrRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5278,7 +6379,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LkRequest); i {
+			switch v := v.(*SetXAttrResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5290,7 +6391,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLkResponse); i {
+			switch v := v.(*RemoveXAttrRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5302,7 +6403,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLkResponse); i {
+			switch v := v.(*RemoveXAttrResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5314,7 +6415,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReleaseRequest); i {
+			switch v := v.(*CreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5326,7 +6427,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteRequest); i {
+			switch v := v.(*CreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5338,7 +6439,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteResponse); i {
+			switch v := v.(*OpenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5350,7 +6451,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CopyFileRangeRequest); i {
+			switch v := v.(*OpenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5362,7 +6463,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CopyFileRangeResponse); i {
+			switch v := v.(*ReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5374,7 +6475,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlushRequest); i {
+			switch v := v.(*ReadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5386,7 +6487,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlushResponse); i {
+			switch v := v.(*LseekRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5398,7 +6499,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FsyncRequest); i {
+			switch v := v.(*LseekResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5410,7 +6511,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FsyncResponse); i {
+			switch v := v.(*LkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5422,7 +6523,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FallocateRequest); i {
+			switch v := v.(*GetLkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5434,7 +6535,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FallocateResponse); i {
+			switch v := v.(*SetLkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5446,7 +6547,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenDirRequest); i {
+			switch v := v.(*ReleaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5458,7 +6559,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenDirResponse); i {
+			switch v := v.(*WriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5470,7 +6571,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadDirRequest); i {
+			switch v := v.(*WriteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5482,7 +6583,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadDirResponse); i {
+			switch v := v.(*CopyFileRangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5494,7 +6595,7 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatfsRequest); i {
+			switch v := v.(*CopyFileRangeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5506,6 +6607,138 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 		file_raw_file_system_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raw_file_system_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raw_file_system_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FsyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raw_file_system_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FsyncResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raw_file_system_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FallocateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raw_file_system_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FallocateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raw_file_system_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenDirRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raw_file_system_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenDirResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raw_file_system_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDirRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raw_file_system_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDirResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raw_file_system_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatfsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raw_file_system_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatfsResponse); i {
 			case 0:
 				return &v.state
@@ -5518,18 +6751,23 @@ func file_raw_file_system_proto_init() {
 			}
 		}
 	}
+	file_raw_file_system_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*NotifyResponse_Inode)(nil),
+		(*NotifyResponse_Entry)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_raw_file_system_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   63,
+			NumEnums:      2,
+			NumMessages:   74,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_raw_file_system_proto_goTypes,
 		DependencyIndexes: file_raw_file_system_proto_depIdxs,
+		EnumInfos:         file_raw_file_system_proto_enumTypes,
 		MessageInfos:      file_raw_file_system_proto_msgTypes,
 	}.Build()
 	File_raw_file_system_proto = out.File
@@ -24,6 +24,23 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type RawFileSystemClient interface {
 	String(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*StringResponse, error)
+	// Init exchanges the protocol version and the features of client and
+	// server. Clients call it before mounting, servers refuse clients of
+	// another major version with FailedPrecondition. Servers without Init
+	// are treated as version 0 supporting everything, the client then
+	// finds out what is missing from the Unimplemented errors.
+	// Once mounted, clients call Init again with the settings of their
+	// kernel and their mount options.
+	Init(ctx context.Context, in *InitRequest, opts ...grpc.CallOption) (*InitResponse, error)
+	// ListExports returns the names of the file systems served besides
+	// the default one. Requests are sent to the file system named by the
+	// grpcfuse-export metadata, the default one if it is missing.
+	ListExports(ctx context.Context, in *ListExportsRequest, opts ...grpc.CallOption) (*ListExportsResponse, error)
+	// Notify streams the cache invalidations caused by the other clients
+	// of the export until the client cancels it. Clients identify
+	// themselves with the grpcfuse-client-id metadata, changes made by
+	// clients without it are sent to every subscriber.
+	Notify(ctx context.Context, in *NotifyRequest, opts ...grpc.CallOption) (RawFileSystem_NotifyClient, error)
 	// Lookup is called by the kernel when the VFS wants to know
 	// about a file inside a directory. Many lookup calls can
 	// occur in parallel, but only one call happens for each (dir,
@@ -36,6 +53,8 @@ type RawFileSystemClient interface {
 	// should not do I/O, as there is no channel to report back
 	// I/O errors.
 	Forget(ctx context.Context, in *ForgetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// BatchForget is Forget for many nodes at once.
+	BatchForget(ctx context.Context, in *BatchForgetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Atributes
 	GetAttr(ctx context.Context, in *GetAttrRequest, opts ...grpc.CallOption) (*GetAttrResponse, error)
 	SetAttr(ctx context.Context, in *SetAttrRequest, opts ...grpc.CallOption) (*SetAttrResponse, error)
@@ -72,6 +91,11 @@ type RawFileSystemClient interface {
 	SetLkw(ctx context.Context, in *LkRequest, opts ...grpc.CallOption) (*SetLkResponse, error)
 	Release(ctx context.Context, in *ReleaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	// WriteStream is Write with the data split into several messages,
+	// so writes may exceed the gRPC message size limit. Only the first
+	// message carries the header and the other fields, the data of all
+	// messages is concatenated into a single Write.
+	WriteStream(ctx context.Context, opts ...grpc.CallOption) (RawFileSystem_WriteStreamClient, error)
 	CopyFileRange(ctx context.Context, in *CopyFileRangeRequest, opts ...grpc.CallOption) (*CopyFileRangeResponse, error)
 	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
 	Fsync(ctx context.Context, in *FsyncRequest, opts ...grpc.CallOption) (*FsyncResponse, error)
@@ -102,6 +126,56 @@ func (c *rawFileSystemClient) String(ctx context.Context, in *StringRequest, opt
 	return out, nil
 }
 
+func (c *rawFileSystemClient) Init(ctx context.Context, in *InitRequest, opts ...grpc.CallOption) (*InitResponse, error) {
+	out := new(InitResponse)
+	err := c.cc.Invoke(ctx, "/pb.RawFileSystem/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rawFileSystemClient) ListExports(ctx context.Context, in *ListExportsRequest, opts ...grpc.CallOption) (*ListExportsResponse, error) {
+	out := new(ListExportsResponse)
+	err := c.cc.Invoke(ctx, "/pb.RawFileSystem/ListExports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rawFileSystemClient) Notify(ctx context.Context, in *NotifyRequest, opts ...grpc.CallOption) (RawFileSystem_NotifyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RawFileSystem_ServiceDesc.Streams[0], "/pb.RawFileSystem/Notify", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rawFileSystemNotifyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RawFileSystem_NotifyClient interface {
+	Recv() (*NotifyResponse, error)
+	grpc.ClientStream
+}
+
+type rawFileSystemNotifyClient struct {
+	grpc.ClientStream
+}
+
+func (x *rawFileSystemNotifyClient) Recv() (*NotifyResponse, error) {
+	m := new(NotifyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *rawFileSystemClient) Lookup(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupResponse, error) {
 	out := new(LookupResponse)
 	err := c.cc.Invoke(ctx, "/pb.RawFileSystem/Lookup", in, out, opts...)
@@ -120,6 +194,15 @@ func (c *rawFileSystemClient) Forget(ctx context.Context, in *ForgetRequest, opt
 	return out, nil
 }
 
+func (c *rawFileSystemClient) BatchForget(ctx context.Context, in *BatchForgetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/pb.RawFileSystem/BatchForget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rawFileSystemClient) GetAttr(ctx context.Context, in *GetAttrRequest, opts ...grpc.CallOption) (*GetAttrResponse, error) {
 	out := new(GetAttrResponse)
 	err := c.cc.Invoke(ctx, "/pb.RawFileSystem/GetAttr", in, out, opts...)
@@ -274,7 +357,7 @@ func (c *rawFileSystemClient) Open(ctx context.Context, in *OpenRequest, opts ..
 }
 
 func (c *rawFileSystemClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (RawFileSystem_ReadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RawFileSystem_ServiceDesc.Streams[0], "/pb.RawFileSystem/Read", opts...)
+	stream, err := c.cc.NewStream(ctx, &RawFileSystem_ServiceDesc.Streams[1], "/pb.RawFileSystem/Read", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -359,6 +442,40 @@ func (c *rawFileSystemClient) Write(ctx context.Context, in *WriteRequest, opts
 	return out, nil
 }
 
+func (c *rawFileSystemClient) WriteStream(ctx context.Context, opts ...grpc.CallOption) (RawFileSystem_WriteStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RawFileSystem_ServiceDesc.Streams[2], "/pb.RawFileSystem/WriteStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rawFileSystemWriteStreamClient{stream}
+	return x, nil
+}
+
+type RawFileSystem_WriteStreamClient interface {
+	Send(*WriteRequest) error
+	CloseAndRecv() (*WriteResponse, error)
+	grpc.ClientStream
+}
+
+type rawFileSystemWriteStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *rawFileSystemWriteStreamClient) Send(m *WriteRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *rawFileSystemWriteStreamClient) CloseAndRecv() (*WriteResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(WriteResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *rawFileSystemClient) CopyFileRange(ctx context.Context, in *CopyFileRangeRequest, opts ...grpc.CallOption) (*CopyFileRangeResponse, error) {
 	out := new(CopyFileRangeResponse)
 	err := c.cc.Invoke(ctx, "/pb.RawFileSystem/CopyFileRange", in, out, opts...)
@@ -405,7 +522,7 @@ func (c *rawFileSystemClient) OpenDir(ctx context.Context, in *OpenDirRequest, o
 }
 
 func (c *rawFileSystemClient) ReadDir(ctx context.Context, in *ReadDirRequest, opts ...grpc.CallOption) (RawFileSystem_ReadDirClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RawFileSystem_ServiceDesc.Streams[1], "/pb.RawFileSystem/ReadDir", opts...)
+	stream, err := c.cc.NewStream(ctx, &RawFileSystem_ServiceDesc.Streams[3], "/pb.RawFileSystem/ReadDir", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -437,7 +554,7 @@ func (x *rawFileSystemReadDirClient) Recv() (*ReadDirResponse, error) {
 }
 
 func (c *rawFileSystemClient) ReadDirPlus(ctx context.Context, in *ReadDirRequest, opts ...grpc.CallOption) (RawFileSystem_ReadDirPlusClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RawFileSystem_ServiceDesc.Streams[2], "/pb.RawFileSystem/ReadDirPlus", opts...)
+	stream, err := c.cc.NewStream(ctx, &RawFileSystem_ServiceDesc.Streams[4], "/pb.RawFileSystem/ReadDirPlus", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -500,6 +617,23 @@ func (c *rawFileSystemClient) StatFs(ctx context.Context, in *StatfsRequest, opt
 // for forward compatibility
 type RawFileSystemServer interface {
 	String(context.Context, *StringRequest) (*StringResponse, error)
+	// Init exchanges the protocol version and the features of client and
+	// server. Clients call it before mounting, servers refuse clients of
+	// another major version with FailedPrecondition. Servers without Init
+	// are treated as version 0 supporting everything, the client then
+	// finds out what is missing from the Unimplemented errors.
+	// Once mounted, clients call Init again with the settings of their
+	// kernel and their mount options.
+	Init(context.Context, *InitRequest) (*InitResponse, error)
+	// ListExports returns the names of the file systems served besides
+	// the default one. Requests are sent to the file system named by the
+	// grpcfuse-export metadata, the default one if it is missing.
+	ListExports(context.Context, *ListExportsRequest) (*ListExportsResponse, error)
+	// Notify streams the cache invalidations caused by the other clients
+	// of the export until the client cancels it. Clients identify
+	// themselves with the grpcfuse-client-id metadata, changes made by
+	// clients without it are sent to every subscriber.
+	Notify(*NotifyRequest, RawFileSystem_NotifyServer) error
 	// Lookup is called by the kernel when the VFS wants to know
 	// about a file inside a directory. Many lookup calls can
 	// occur in parallel, but only one call happens for each (dir,
@@ -512,6 +646,8 @@ type RawFileSystemServer interface {
 	// should not do I/O, as there is no channel to report back
 	// I/O errors.
 	Forget(context.Context, *ForgetRequest) (*emptypb.Empty, error)
+	// BatchForget is Forget for many nodes at once.
+	BatchForget(context.Context, *BatchForgetRequest) (*emptypb.Empty, error)
 	// Atributes
 	GetAttr(context.Context, *GetAttrRequest) (*GetAttrResponse, error)
 	SetAttr(context.Context, *SetAttrRequest) (*SetAttrResponse, error)
@@ -548,6 +684,11 @@ type RawFileSystemServer interface {
 	SetLkw(context.Context, *LkRequest) (*SetLkResponse, error)
 	Release(context.Context, *ReleaseRequest) (*emptypb.Empty, error)
 	Write(context.Context, *WriteRequest) (*WriteResponse, error)
+	// WriteStream is Write with the data split into several messages,
+	// so writes may exceed the gRPC message size limit. Only the first
+	// message carries the header and the other fields, the data of all
+	// messages is concatenated into a single Write.
+	WriteStream(RawFileSystem_WriteStreamServer) error
 	CopyFileRange(context.Context, *CopyFileRangeRequest) (*CopyFileRangeResponse, error)
 	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
 	Fsync(context.Context, *FsyncRequest) (*FsyncResponse, error)
@@ -569,12 +710,24 @@ type UnimplementedRawFileSystemServer struct {
 func (UnimplementedRawFileSystemServer) String(context.Context, *StringRequest) (*StringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method String not implemented")
 }
+func (UnimplementedRawFileSystemServer) Init(context.Context, *InitRequest) (*InitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedRawFileSystemServer) ListExports(context.Context, *ListExportsRequest) (*ListExportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExports not implemented")
+}
+func (UnimplementedRawFileSystemServer) Notify(*NotifyRequest, RawFileSystem_NotifyServer) error {
+	return status.Errorf(codes.Unimplemented, "method Notify not implemented")
+}
 func (UnimplementedRawFileSystemServer) Lookup(context.Context, *LookupRequest) (*LookupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Lookup not implemented")
 }
 func (UnimplementedRawFileSystemServer) Forget(context.Context, *ForgetRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Forget not implemented")
 }
+func (UnimplementedRawFileSystemServer) BatchForget(context.Context, *BatchForgetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchForget not implemented")
+}
 func (UnimplementedRawFileSystemServer) GetAttr(context.Context, *GetAttrRequest) (*GetAttrResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAttr not implemented")
 }
@@ -647,6 +800,9 @@ func (UnimplementedRawFileSystemServer) Release(context.Context, *ReleaseRequest
 func (UnimplementedRawFileSystemServer) Write(context.Context, *WriteRequest) (*WriteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
 }
+func (UnimplementedRawFileSystemServer) WriteStream(RawFileSystem_WriteStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method WriteStream not implemented")
+}
 func (UnimplementedRawFileSystemServer) CopyFileRange(context.Context, *CopyFileRangeRequest) (*CopyFileRangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CopyFileRange not implemented")
 }
@@ -708,6 +864,63 @@ func _RawFileSystem_String_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RawFileSystem_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RawFileSystemServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.RawFileSystem/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RawFileSystemServer).Init(ctx, req.(*InitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RawFileSystem_ListExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RawFileSystemServer).ListExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.RawFileSystem/ListExports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RawFileSystemServer).ListExports(ctx, req.(*ListExportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RawFileSystem_Notify_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NotifyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RawFileSystemServer).Notify(m, &rawFileSystemNotifyServer{stream})
+}
+
+type RawFileSystem_NotifyServer interface {
+	Send(*NotifyResponse) error
+	grpc.ServerStream
+}
+
+type rawFileSystemNotifyServer struct {
+	grpc.ServerStream
+}
+
+func (x *rawFileSystemNotifyServer) Send(m *NotifyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _RawFileSystem_Lookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LookupRequest)
 	if err := dec(in); err != nil {
@@ -744,6 +957,24 @@ func _RawFileSystem_Forget_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RawFileSystem_BatchForget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchForgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RawFileSystemServer).BatchForget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.RawFileSystem/BatchForget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RawFileSystemServer).BatchForget(ctx, req.(*BatchForgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RawFileSystem_GetAttr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAttrRequest)
 	if err := dec(in); err != nil {
@@ -1179,6 +1410,32 @@ func _RawFileSystem_Write_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RawFileSystem_WriteStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RawFileSystemServer).WriteStream(&rawFileSystemWriteStreamServer{stream})
+}
+
+type RawFileSystem_WriteStreamServer interface {
+	SendAndClose(*WriteResponse) error
+	Recv() (*WriteRequest, error)
+	grpc.ServerStream
+}
+
+type rawFileSystemWriteStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *rawFileSystemWriteStreamServer) SendAndClose(m *WriteResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *rawFileSystemWriteStreamServer) Recv() (*WriteRequest, error) {
+	m := new(WriteRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _RawFileSystem_CopyFileRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CopyFileRangeRequest)
 	if err := dec(in); err != nil {
@@ -1376,6 +1633,14 @@ var RawFileSystem_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "String",
 			Handler:    _RawFileSystem_String_Handler,
 		},
+		{
+			MethodName: "Init",
+			Handler:    _RawFileSystem_Init_Handler,
+		},
+		{
+			MethodName: "ListExports",
+			Handler:    _RawFileSystem_ListExports_Handler,
+		},
 		{
 			MethodName: "Lookup",
 			Handler:    _RawFileSystem_Lookup_Handler,
@@ -1384,6 +1649,10 @@ var RawFileSystem_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Forget",
 			Handler:    _RawFileSystem_Forget_Handler,
 		},
+		{
+			MethodName: "BatchForget",
+			Handler:    _RawFileSystem_BatchForget_Handler,
+		},
 		{
 			MethodName: "GetAttr",
 			Handler:    _RawFileSystem_GetAttr_Handler,
@@ -1510,11 +1779,21 @@ var RawFileSystem_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Notify",
+			Handler:       _RawFileSystem_Notify_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Read",
 			Handler:       _RawFileSystem_Read_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WriteStream",
+			Handler:       _RawFileSystem_WriteStream_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "ReadDir",
 			Handler:       _RawFileSystem_ReadDir_Handler,
@@ -923,6 +923,9 @@ type DirEntry struct {
 	Mode uint32 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
 	Ino  uint64 `protobuf:"varint,2,opt,name=ino,proto3" json:"ino,omitempty"`
 	Name []byte `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// entry_out is the result of looking up the entry, it is only set
+	// by ReadDirPlus.
+	EntryOut *EntryOut `protobuf:"bytes,4,opt,name=entry_out,json=entryOut,proto3" json:"entry_out,omitempty"`
 }
 
 func (x *DirEntry) Reset() {
@@ -978,6 +981,13 @@ func (x *DirEntry) GetName() []byte {
 	return nil
 }
 
+func (x *DirEntry) GetEntryOut() *EntryOut {
+	if x != nil {
+		return x.EntryOut
+	}
+	return nil
+}
+
 var File_shared_proto protoreflect.FileDescriptor
 
 var file_shared_proto_rawDesc = []byte{
@@ -1085,14 +1095,17 @@ var file_shared_proto_rawDesc = []byte{
 	0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x08, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64, 0x22, 0x6f, 0x0a, 0x08, 0x44,
 	0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69,
 	0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x69, 0x6e, 0x6f, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x68, 0x69, 0x79, 0x75, 0x74, 0x69, 0x61, 0x6e, 0x79, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63,
-	0x66, 0x75, 0x73, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x29, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f,
+	0x75, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x42, 0x24, 0x5a, 0x22,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x69, 0x79, 0x75,
+	0x74, 0x69, 0x61, 0x6e, 0x79, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x66, 0x75, 0x73, 0x65, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1130,11 +1143,12 @@ var file_shared_proto_depIdxs = []int32{
 	4, // 4: pb.EntryOut.attr:type_name -> pb.Attr
 	3, // 5: pb.OpenIn.header:type_name -> pb.InHeader
 	3, // 6: pb.ReadIn.header:type_name -> pb.InHeader
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	6, // 7: pb.DirEntry.entry_out:type_name -> pb.EntryOut
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_shared_proto_init() }
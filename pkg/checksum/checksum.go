@@ -0,0 +1,72 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package checksum protects the data of reads and writes against
+// corruption between clients and servers.
+package checksum
+
+import (
+	"hash/crc32"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+var (
+	castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+	mismatches = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpcfuse_checksum_mismatches_total",
+		Help: "Data received with a wrong checksum.",
+	}, []string{"method"})
+)
+
+func init() {
+	prometheus.MustRegister(mismatches)
+}
+
+// Supported reports whether t is a known checksum.
+func Supported(t pb.ChecksumType) bool {
+	switch t {
+	case pb.ChecksumType_CHECKSUM_CRC32C, pb.ChecksumType_CHECKSUM_XXHASH:
+		return true
+	}
+	return false
+}
+
+// Sum returns the checksum t of data, 0 for CHECKSUM_NONE.
+func Sum(t pb.ChecksumType, data []byte) uint64 {
+	switch t {
+	case pb.ChecksumType_CHECKSUM_CRC32C:
+		return uint64(crc32.Checksum(data, castagnoli))
+	case pb.ChecksumType_CHECKSUM_XXHASH:
+		return xxhash.Sum64(data)
+	}
+	return 0
+}
+
+// Verify reports whether sum is the checksum t of data. Mismatches of
+// the data received by method are counted. Data without a checksum is
+// always fine.
+func Verify(t pb.ChecksumType, data []byte, sum uint64, method string) bool {
+	if !Supported(t) || Sum(t, data) == sum {
+		return true
+	}
+	mismatches.WithLabelValues(method).Inc()
+	return false
+}
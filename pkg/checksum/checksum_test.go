@@ -0,0 +1,43 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checksum_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/pkg/checksum"
+)
+
+func TestChecksum(t *testing.T) {
+	data := []byte("hello world")
+
+	// well-known values of "hello world"
+	require.Equal(t, uint64(0xc99465aa), checksum.Sum(pb.ChecksumType_CHECKSUM_CRC32C, data))
+	require.Equal(t, uint64(0x45ab6734b21e6968), checksum.Sum(pb.ChecksumType_CHECKSUM_XXHASH, data))
+	require.Equal(t, uint64(0), checksum.Sum(pb.ChecksumType_CHECKSUM_NONE, data))
+
+	for _, typ := range []pb.ChecksumType{pb.ChecksumType_CHECKSUM_CRC32C, pb.ChecksumType_CHECKSUM_XXHASH} {
+		sum := checksum.Sum(typ, data)
+		require.True(t, checksum.Verify(typ, data, sum, "Test"))
+		require.False(t, checksum.Verify(typ, []byte("hello worle"), sum, "Test"))
+	}
+	// data without a checksum
+	require.True(t, checksum.Verify(pb.ChecksumType_CHECKSUM_NONE, data, 42, "Test"))
+}
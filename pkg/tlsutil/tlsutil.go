@@ -0,0 +1,150 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package tlsutil builds the gRPC transport credentials used between
+// grpc2fuse clients and fuse2grpc servers.
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io/ioutil"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Config describes the TLS setup of one side of the connection.
+type Config struct {
+	// Enabled turns on TLS even without any file set, a client then
+	// verifies the server against the system roots.
+	Enabled bool
+	// CertFile and KeyFile are the PEM encoded certificate and key
+	// presented to the peer. A server needs them, a client only for
+	// mutual TLS.
+	CertFile string
+	KeyFile  string
+	// CAFile is the PEM encoded CA bundle the peer is verified with.
+	// On a server it makes client certificates mandatory.
+	CAFile string
+	// ServerName overrides the name the client verifies the server
+	// certificate for.
+	ServerName string
+}
+
+// AddFlags registers the -tls flags on f.
+func (c *Config) AddFlags(f *flag.FlagSet) {
+	c.AddPrefixedFlags(f, "")
+}
+
+// AddPrefixedFlags registers the -tls flags on f with their names
+// prefixed, for programs securing more than one connection.
+func (c *Config) AddPrefixedFlags(f *flag.FlagSet, prefix string) {
+	f.BoolVar(&c.Enabled, prefix+"tls", false, "use TLS")
+	f.StringVar(&c.CertFile, prefix+"tls-cert", "", "PEM certificate file presented to the peer")
+	f.StringVar(&c.KeyFile, prefix+"tls-key", "", "PEM private key file of -"+prefix+"tls-cert")
+	f.StringVar(&c.CAFile, prefix+"tls-ca", "", "PEM CA file to verify the peer with, makes client certificates mandatory on the server")
+	f.StringVar(&c.ServerName, prefix+"tls-server-name", "", "server name to verify the server certificate for")
+}
+
+// Active reports whether TLS is used.
+func (c *Config) Active() bool {
+	return c.Enabled || c.CertFile != "" || c.KeyFile != "" || c.CAFile != ""
+}
+
+// ServerTLSConfig returns the tls.Config of a server.
+func (c *Config) ServerTLSConfig() (*tls.Config, error) {
+	if c.CertFile == "" || c.KeyFile == "" {
+		return nil, fmt.Errorf("tls: server needs both a certificate and a key")
+	}
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("tls: load key pair: %v", err)
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if c.CAFile != "" {
+		if cfg.ClientCAs, err = loadCA(c.CAFile); err != nil {
+			return nil, err
+		}
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
+
+// ClientTLSConfig returns the tls.Config of a client.
+func (c *Config) ClientTLSConfig() (*tls.Config, error) {
+	cfg := &tls.Config{
+		ServerName: c.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("tls: load key pair: %v", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	if c.CAFile != "" {
+		var err error
+		if cfg.RootCAs, err = loadCA(c.CAFile); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
+// ServerOption returns the option securing a grpc.Server, or an empty
+// option if TLS is not active.
+func (c *Config) ServerOption() (grpc.ServerOption, error) {
+	if !c.Active() {
+		return grpc.EmptyServerOption{}, nil
+	}
+	cfg, err := c.ServerTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	return grpc.Creds(credentials.NewTLS(cfg)), nil
+}
+
+// DialOption returns the option securing a grpc.ClientConn, or
+// grpc.WithInsecure if TLS is not active.
+func (c *Config) DialOption() (grpc.DialOption, error) {
+	if !c.Active() {
+		return grpc.WithInsecure(), nil
+	}
+	cfg, err := c.ClientTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(cfg)), nil
+}
+
+func loadCA(file string) (*x509.CertPool, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("tls: read CA: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("tls: no certificate found in %s", file)
+	}
+	return pool, nil
+}
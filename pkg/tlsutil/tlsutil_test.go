@@ -0,0 +1,176 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tlsutil_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"flag"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/chiyutianyi/grpcfuse/pkg/tlsutil"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newCert(t *testing.T, name string, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{name},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, key: key}
+}
+
+// write stores c in dir and returns the certificate and key file names.
+func (c *testCert) write(t *testing.T, dir, name string) (string, string) {
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw})
+	require.NoError(t, ioutil.WriteFile(certFile, certPEM, 0600))
+
+	der, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+	require.NoError(t, ioutil.WriteFile(keyFile, keyPEM, 0600))
+	return certFile, keyFile
+}
+
+func startServer(t *testing.T, cfg *tlsutil.Config) (*grpc.Server, string) {
+	creds, err := cfg.ServerOption()
+	require.NoError(t, err)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := grpc.NewServer(creds)
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	go s.Serve(l)
+	return s, l.Addr().String()
+}
+
+func check(t *testing.T, addr string, cfg *tlsutil.Config) error {
+	creds, err := cfg.DialOption()
+	require.NoError(t, err)
+
+	conn, err := grpc.Dial(addr, creds)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+
+	ca := newCert(t, "ca", nil)
+	caFile, _ := ca.write(t, dir, "ca")
+	serverCert, serverKey := newCert(t, "server", ca).write(t, dir, "server")
+	clientCert, clientKey := newCert(t, "client", ca).write(t, dir, "client")
+
+	s, addr := startServer(t, &tlsutil.Config{CertFile: serverCert, KeyFile: serverKey, CAFile: caFile})
+	defer s.Stop()
+
+	require.NoError(t, check(t, addr, &tlsutil.Config{
+		CertFile:   clientCert,
+		KeyFile:    clientKey,
+		CAFile:     caFile,
+		ServerName: "server",
+	}))
+
+	// no client certificate
+	require.Error(t, check(t, addr, &tlsutil.Config{CAFile: caFile, ServerName: "server"}))
+	// plaintext
+	require.Error(t, check(t, addr, &tlsutil.Config{}))
+}
+
+func TestServerOnlyTLS(t *testing.T) {
+	dir := t.TempDir()
+
+	ca := newCert(t, "ca", nil)
+	caFile, _ := ca.write(t, dir, "ca")
+	serverCert, serverKey := newCert(t, "server", ca).write(t, dir, "server")
+
+	s, addr := startServer(t, &tlsutil.Config{CertFile: serverCert, KeyFile: serverKey})
+	defer s.Stop()
+
+	require.NoError(t, check(t, addr, &tlsutil.Config{CAFile: caFile, ServerName: "server"}))
+	// unknown CA
+	require.Error(t, check(t, addr, &tlsutil.Config{Enabled: true, ServerName: "server"}))
+}
+
+func TestServerNeedsKeyPair(t *testing.T) {
+	_, err := (&tlsutil.Config{Enabled: true}).ServerOption()
+	require.Error(t, err)
+
+	opt, err := (&tlsutil.Config{}).ServerOption()
+	require.NoError(t, err)
+	require.Equal(t, grpc.EmptyServerOption{}, opt)
+}
+
+func TestPrefixedFlags(t *testing.T) {
+	f := flag.NewFlagSet("test", flag.ContinueOnError)
+	var client, server tlsutil.Config
+	client.AddFlags(f)
+	server.AddPrefixedFlags(f, "http-")
+	require.NoError(t, f.Parse([]string{"-tls-ca", "ca.pem", "-http-tls-cert", "cert.pem", "-http-tls-key", "key.pem"}))
+
+	require.Equal(t, tlsutil.Config{CAFile: "ca.pem"}, client)
+	require.Equal(t, tlsutil.Config{CertFile: "cert.pem", KeyFile: "key.pem"}, server)
+}
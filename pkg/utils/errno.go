@@ -0,0 +1,47 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"syscall"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+// ErrnoError returns an error with code c carrying errno as a pb.Status
+// detail. Clients return the errno to the kernel instead of one mapped
+// from c.
+func ErrnoError(c codes.Code, errno syscall.Errno, msg string) error {
+	st := status.New(c, msg)
+	if detailed, err := st.WithDetails(&pb.Status{Code: int32(errno)}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// ErrnoFromStatus returns the errno attached to st by ErrnoError.
+func ErrnoFromStatus(st *status.Status) (syscall.Errno, bool) {
+	for _, detail := range st.Details() {
+		if s, ok := detail.(*pb.Status); ok {
+			return syscall.Errno(s.Code), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// ClientIDKey is the gRPC metadata key identifying the client, the
+	// server keeps the handles, lookups and locks of a client under it.
+	ClientIDKey = "grpcfuse-client-id"
+
+	// ExportKey is the gRPC metadata key selecting the file system of
+	// a server serving several ones.
+	ExportKey = "grpcfuse-export"
+)
+
+// FromIncomingContext returns the first value of key in the metadata of
+// ctx.
+func FromIncomingContext(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// NewClientID returns a random id, so that the server can tell the
+// requests of a client from those of other clients.
+func NewClientID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		log.Fatalf("generate client id: %v", err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"github.com/chiyutianyi/grpcfuse/pb"
+)
+
+const (
+	// ProtocolMajor is the major version of the protocol spoken by
+	// grpcfuse. Peers of different major versions refuse each other.
+	ProtocolMajor = 1
+	// ProtocolMinor is the minor version of the protocol, it is bumped
+	// by changes old peers can ignore.
+	ProtocolMinor = 0
+
+	// Features are the pb.Feature bits implemented by this version of
+	// grpcfuse.
+	Features = uint64(pb.Feature_FEATURE_WRITE_STREAM |
+		pb.Feature_FEATURE_BATCH_FORGET |
+		pb.Feature_FEATURE_READDIRPLUS_ENTRIES |
+		pb.Feature_FEATURE_NOTIFY |
+		pb.Feature_FEATURE_LOCKS |
+		pb.Feature_FEATURE_COMPRESSION |
+		pb.Feature_FEATURE_CHECKSUMS)
+)
+
+// HasFeature reports whether the feature bit f is set in features.
+func HasFeature(features uint64, f pb.Feature) bool {
+	return features&uint64(f) != 0
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webdavfs
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/webdav"
+
+	"github.com/chiyutianyi/grpcfuse/grpcfs"
+)
+
+type lockSystem struct {
+	// webdav.LockSystem keeps the tokens and resolves the conflicts
+	// between WebDAV clients.
+	webdav.LockSystem
+
+	fsys *grpcfs.FS
+
+	mu    sync.Mutex
+	locks map[string]*serverLock
+}
+
+// serverLock is the POSIX lock a WebDAV lock holds on the server.
+type serverLock struct {
+	name string
+	// file is nil until the lock is taken, for the locks of files
+	// created after being locked.
+	file *grpcfs.File
+	// expiry is zero for locks which never expire.
+	expiry time.Time
+}
+
+// NewLockSystem returns a webdav.LockSystem which takes an exclusive
+// SetLk lock on the server for every lock of a file, so the mounts of
+// the export and the WebDAV clients see each other's locks. Collections
+// are only locked against WebDAV clients.
+//
+// A missing file is locked on the server by the next request after it
+// is created.
+func NewLockSystem(fsys *grpcfs.FS) webdav.LockSystem {
+	return &lockSystem{
+		LockSystem: webdav.NewMemLS(),
+		fsys:       fsys,
+		locks:      make(map[string]*serverLock),
+	}
+}
+
+func expiry(now time.Time, duration time.Duration) time.Time {
+	if duration < 0 {
+		return time.Time{}
+	}
+	return now.Add(duration)
+}
+
+// settle releases the locks expired at now and takes the pending ones
+// whose file exists by now, l.mu must be held.
+func (l *lockSystem) settle(now time.Time) {
+	for token, lock := range l.locks {
+		if !lock.expiry.IsZero() && !now.Before(lock.expiry) {
+			if lock.file != nil {
+				lock.file.Close()
+			}
+			delete(l.locks, token)
+			continue
+		}
+		if lock.file == nil {
+			lock.file, _ = l.lockFile(lock.name)
+		}
+	}
+}
+
+func (l *lockSystem) Confirm(now time.Time, name0, name1 string, conditions ...webdav.Condition) (func(), error) {
+	l.mu.Lock()
+	l.settle(now)
+	l.mu.Unlock()
+	return l.LockSystem.Confirm(now, name0, name1, conditions...)
+}
+
+func (l *lockSystem) Create(now time.Time, details webdav.LockDetails) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.settle(now)
+	name := fsPath(details.Root)
+	info, err := l.fsys.Stat(name)
+	missing := errors.Is(err, fs.ErrNotExist)
+	if err != nil && !missing {
+		return "", err
+	}
+	isFile := !missing && !info.IsDir()
+
+	token, err := l.LockSystem.Create(now, details)
+	if err != nil {
+		return "", err
+	}
+	var file *grpcfs.File
+	if isFile {
+		if file, err = l.lockFile(name); err != nil {
+			l.LockSystem.Unlock(now, token)
+			return "", err
+		}
+	}
+	if isFile || missing {
+		l.locks[token] = &serverLock{name: name, file: file, expiry: expiry(now, details.Duration)}
+	}
+	return token, nil
+}
+
+// lockFile locks the file name on the server. It returns nil without
+// error for the files which can not be locked, such as directories,
+// missing files or read-only ones.
+func (l *lockSystem) lockFile(name string) (*grpcfs.File, error) {
+	f, err := l.fsys.OpenFile(name, os.O_RDWR, 0)
+	switch errno := errors.Unwrap(err); errno {
+	case nil:
+	case syscall.ENOENT, syscall.EISDIR, syscall.EROFS, syscall.EACCES:
+		return nil, nil
+	default:
+		return nil, err
+	}
+	file, ok := f.(*grpcfs.File)
+	if !ok {
+		f.Close()
+		return nil, nil
+	}
+	if err := file.TryLock(true); err != nil {
+		file.Close()
+		if errors.Is(err, syscall.EAGAIN) {
+			return nil, webdav.ErrLocked
+		}
+		return nil, err
+	}
+	return file, nil
+}
+
+func (l *lockSystem) Refresh(now time.Time, token string, duration time.Duration) (webdav.LockDetails, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.settle(now)
+	details, err := l.LockSystem.Refresh(now, token, duration)
+	if err != nil {
+		return details, err
+	}
+	if lock, ok := l.locks[token]; ok {
+		lock.expiry = expiry(now, duration)
+	}
+	return details, nil
+}
+
+func (l *lockSystem) Unlock(now time.Time, token string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if lock, ok := l.locks[token]; ok {
+		if lock.file != nil {
+			lock.file.Close()
+		}
+		delete(l.locks, token)
+	}
+	l.settle(now)
+	return l.LockSystem.Unlock(now, token)
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webdavfs
+
+import "net/http"
+
+// readOnlyMethods are the WebDAV methods which leave the file system and
+// its locks alone.
+var readOnlyMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	"PROPFIND":         true,
+}
+
+// ReadOnly wraps h, rejecting every request which could modify the file
+// system with 403 Forbidden: PUT, DELETE, MKCOL, COPY, MOVE, PROPPATCH,
+// LOCK, UNLOCK and any unknown method.
+func ReadOnly(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !readOnlyMethods[r.Method] {
+			http.Error(w, r.Method+": read-only", http.StatusForbidden)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
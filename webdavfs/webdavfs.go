@@ -0,0 +1,123 @@
+/*
+ * Copyright 2022 Han Xin, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package webdavfs serves the file systems of fuse2grpc over WebDAV, for
+// machines without FUSE and for browsers.
+package webdavfs
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"syscall"
+
+	"golang.org/x/net/webdav"
+
+	"github.com/chiyutianyi/grpcfuse/grpcfs"
+)
+
+type fileSystem struct {
+	fsys *grpcfs.FS
+}
+
+// New returns a webdav.FileSystem making the gRPC calls of fsys.
+func New(fsys *grpcfs.FS) webdav.FileSystem {
+	return &fileSystem{fsys: fsys}
+}
+
+// fsPath converts the slash rooted names of webdav to the paths of
+// io/fs.
+func fsPath(name string) string {
+	name = strings.Trim(path.Clean("/"+name), "/")
+	if name == "" {
+		return "."
+	}
+	return name
+}
+
+func (f *fileSystem) Mkdir(ctx context.Context, name string, perm os.FileMode) error {
+	return f.fsys.WithRequestContext(ctx).Mkdir(fsPath(name), perm)
+}
+
+func (f *fileSystem) OpenFile(ctx context.Context, name string, flag int, perm os.FileMode) (webdav.File, error) {
+	// Files outlive the request opening them, which only bounds the
+	// open itself.
+	file, err := f.fsys.OpenFile(fsPath(name), flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	switch file := file.(type) {
+	case *grpcfs.File:
+		return &regularFile{file}, nil
+	case fs.ReadDirFile:
+		return &dir{file}, nil
+	}
+	file.Close()
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+}
+
+func (f *fileSystem) RemoveAll(ctx context.Context, name string) error {
+	if fsPath(name) == "." {
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrPermission}
+	}
+	return f.fsys.WithRequestContext(ctx).RemoveAll(fsPath(name))
+}
+
+func (f *fileSystem) Rename(ctx context.Context, oldName, newName string) error {
+	return f.fsys.WithRequestContext(ctx).Rename(fsPath(oldName), fsPath(newName))
+}
+
+func (f *fileSystem) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	return f.fsys.WithRequestContext(ctx).Stat(fsPath(name))
+}
+
+// regularFile is an open file, it can not be listed.
+type regularFile struct {
+	*grpcfs.File
+}
+
+func (f *regularFile) Readdir(count int) ([]fs.FileInfo, error) {
+	return nil, &fs.PathError{Op: "readdir", Path: f.Name(), Err: syscall.ENOTDIR}
+}
+
+// dir is an open directory, it can only be listed.
+type dir struct {
+	fs.ReadDirFile
+}
+
+func (d *dir) Readdir(count int) ([]fs.FileInfo, error) {
+	entries, err := d.ReadDir(count)
+	infos := make([]fs.FileInfo, 0, len(entries))
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil {
+			// removed since listed
+			continue
+		}
+		infos = append(infos, info)
+	}
+	return infos, err
+}
+
+func (d *dir) Seek(offset int64, whence int) (int64, error) {
+	return 0, syscall.EISDIR
+}
+
+func (d *dir) Write(p []byte) (int, error) {
+	return 0, syscall.EISDIR
+}
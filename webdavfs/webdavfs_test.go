@@ -0,0 +1,182 @@
+package webdavfs_test
+
+import (
+	"io"
+	"io/fs"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/webdav"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/chiyutianyi/grpcfuse/fuse2grpc"
+	"github.com/chiyutianyi/grpcfuse/grpcfs"
+	"github.com/chiyutianyi/grpcfuse/memfs"
+	"github.com/chiyutianyi/grpcfuse/pb"
+	"github.com/chiyutianyi/grpcfuse/webdavfs"
+)
+
+const lockInfo = `<?xml version="1.0" encoding="utf-8"?>
+<D:lockinfo xmlns:D="DAV:"><D:lockscope><D:exclusive/></D:lockscope><D:locktype><D:write/></D:locktype><D:owner>test</D:owner></D:lockinfo>`
+
+// serve returns a WebDAV server and a client of the same in-memory file
+// system.
+func serve(t *testing.T, readOnly bool) (*httptest.Server, *grpcfs.FS) {
+	socket := filepath.Join(t.TempDir(), "webdav.sock")
+	l, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	srv := fuse2grpc.NewServer(memfs.New(memfs.Options{
+		Owner: fuse.Owner{Uid: uint32(os.Getuid()), Gid: uint32(os.Getgid())},
+	}))
+	s := grpc.NewServer(grpc.StatsHandler(srv.StatsHandler()))
+	pb.RegisterRawFileSystemServer(s, srv)
+	go s.Serve(l)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	fsys := grpcfs.New(pb.NewRawFileSystemClient(conn))
+	var handler http.Handler = &webdav.Handler{
+		FileSystem: webdavfs.New(fsys),
+		LockSystem: webdavfs.NewLockSystem(fsys),
+	}
+	if readOnly {
+		handler = webdavfs.ReadOnly(handler)
+	}
+	dav := httptest.NewServer(handler)
+	t.Cleanup(dav.Close)
+	return dav, grpcfs.New(pb.NewRawFileSystemClient(conn))
+}
+
+func do(t *testing.T, method, url, body string, header map[string]string) (*http.Response, string) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	return res, string(data)
+}
+
+func TestWebDAV(t *testing.T) {
+	dav, fsys := serve(t, false)
+
+	res, _ := do(t, "MKCOL", dav.URL+"/dir", "", nil)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	res, _ = do(t, "PUT", dav.URL+"/dir/file", "hello world", nil)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	data, err := fsys.ReadFile("dir/file")
+	require.NoError(t, err)
+	require.Equal(t, "hello world", string(data))
+
+	res, body := do(t, "GET", dav.URL+"/dir/file", "", map[string]string{"Range": "bytes=6-10"})
+	require.Equal(t, http.StatusPartialContent, res.StatusCode)
+	require.Equal(t, "world", body)
+
+	res, body = do(t, "PROPFIND", dav.URL+"/dir", "", map[string]string{"Depth": "1"})
+	require.Equal(t, http.StatusMultiStatus, res.StatusCode)
+	require.Contains(t, body, "/dir/file")
+	require.Contains(t, body, "<D:getcontentlength>11</D:getcontentlength>")
+
+	res, _ = do(t, "MOVE", dav.URL+"/dir/file", "", map[string]string{"Destination": dav.URL + "/dir/moved"})
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	_, err = fsys.Stat("dir/file")
+	require.ErrorIs(t, err, fs.ErrNotExist)
+
+	res, _ = do(t, "DELETE", dav.URL+"/dir", "", nil)
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+	_, err = fsys.Stat("dir")
+	require.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestLock(t *testing.T) {
+	dav, fsys := serve(t, false)
+	require.NoError(t, fsys.WriteFile("file", []byte("old"), 0644))
+
+	res, _ := do(t, "LOCK", dav.URL+"/file", lockInfo, nil)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	token := res.Header.Get("Lock-Token")
+	require.NotEmpty(t, token)
+
+	// the mounts of the export see the lock
+	f, err := fsys.OpenFile("file", os.O_RDWR, 0)
+	require.NoError(t, err)
+	defer f.Close()
+	file := f.(*grpcfs.File)
+	require.ErrorIs(t, file.TryLock(true), syscall.EAGAIN)
+
+	res, _ = do(t, "PUT", dav.URL+"/file", "data", nil)
+	require.Equal(t, http.StatusLocked, res.StatusCode)
+	res, _ = do(t, "PUT", dav.URL+"/file", "data", map[string]string{"If": "(" + token + ")"})
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	res, _ = do(t, "UNLOCK", dav.URL+"/file", "", map[string]string{"Lock-Token": token})
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+	require.NoError(t, file.TryLock(true))
+
+	// and WebDAV clients see the locks of the mounts
+	res, _ = do(t, "LOCK", dav.URL+"/file", lockInfo, nil)
+	require.Equal(t, http.StatusLocked, res.StatusCode)
+	res, _ = do(t, "PUT", dav.URL+"/file", "data", nil)
+	require.Equal(t, http.StatusLocked, res.StatusCode)
+	require.NoError(t, file.Unlock())
+}
+
+func TestLockMissing(t *testing.T) {
+	dav, fsys := serve(t, false)
+
+	res, _ := do(t, "LOCK", dav.URL+"/new", lockInfo, nil)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	token := res.Header.Get("Lock-Token")
+
+	// the file is locked on the server by the next request
+	res, _ = do(t, "PUT", dav.URL+"/new", "data", map[string]string{"If": "(" + token + ")"})
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	f, err := fsys.OpenFile("new", os.O_RDWR, 0)
+	require.NoError(t, err)
+	defer f.Close()
+	require.ErrorIs(t, f.(*grpcfs.File).TryLock(true), syscall.EAGAIN)
+
+	res, _ = do(t, "UNLOCK", dav.URL+"/new", "", map[string]string{"Lock-Token": token})
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+	require.NoError(t, f.(*grpcfs.File).TryLock(true))
+}
+
+func TestReadOnly(t *testing.T) {
+	dav, fsys := serve(t, true)
+	require.NoError(t, fsys.WriteFile("file", []byte("hello"), 0644))
+
+	res, body := do(t, "GET", dav.URL+"/file", "", nil)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "hello", body)
+	res, _ = do(t, "PROPFIND", dav.URL+"/", "", map[string]string{"Depth": "1"})
+	require.Equal(t, http.StatusMultiStatus, res.StatusCode)
+
+	for _, method := range []string{"PUT", "DELETE", "MKCOL", "COPY", "MOVE", "PROPPATCH", "LOCK", "UNLOCK", "POST"} {
+		res, _ := do(t, method, dav.URL+"/file", lockInfo, map[string]string{"Destination": dav.URL + "/copy"})
+		require.Equal(t, http.StatusForbidden, res.StatusCode, method)
+	}
+	data, err := fsys.ReadFile("file")
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(data))
+	_, err = fsys.Stat("copy")
+	require.ErrorIs(t, err, fs.ErrNotExist)
+}